@@ -0,0 +1,278 @@
+package enumerators
+
+import (
+	"errors"
+
+	"golang.org/x/exp/constraints"
+)
+
+var (
+	// ErrNoElements is returned when an operator requires at least one item.
+	ErrNoElements = errors.New("enumerator contains no elements")
+	// ErrMoreThanOneElement is returned by Single when more than one item exists.
+	ErrMoreThanOneElement = errors.New("enumerator contains more than one element")
+	// ErrOutOfRange is returned by ElementAt when the index is not available.
+	ErrOutOfRange = errors.New("index out of range")
+)
+
+// Number is the set of types that support arithmetic averaging.
+type Number interface {
+	constraints.Integer | constraints.Float
+}
+
+// Fold combines all elements into an accumulator starting from seed.
+func Fold[T any, U any](enumerator Enumerator[T], seed U, fold func(acc U, item T) (U, error)) (U, error) {
+	defer enumerator.Dispose()
+	acc := seed
+	for enumerator.MoveNext() {
+		item, err := enumerator.Current()
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+
+		acc, err = fold(acc, item)
+		if err != nil {
+			var zero U
+			return zero, err
+		}
+	}
+
+	if err := enumerator.Err(); err != nil {
+		var zero U
+		return zero, err
+	}
+	return acc, nil
+}
+
+// Reduce combines all elements using the first element as the seed. It
+// returns ErrNoElements when the enumerator is empty.
+func Reduce[T any](enumerator Enumerator[T], reduce func(acc T, item T) (T, error)) (T, error) {
+	defer enumerator.Dispose()
+	var acc T
+	seeded := false
+	for enumerator.MoveNext() {
+		item, err := enumerator.Current()
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+
+		if !seeded {
+			acc = item
+			seeded = true
+			continue
+		}
+
+		acc, err = reduce(acc, item)
+		if err != nil {
+			var zero T
+			return zero, err
+		}
+	}
+
+	if err := enumerator.Err(); err != nil {
+		var zero T
+		return zero, err
+	}
+	if !seeded {
+		var zero T
+		return zero, ErrNoElements
+	}
+	return acc, nil
+}
+
+// Count returns the number of elements.
+func Count[T any](enumerator Enumerator[T]) (int, error) {
+	if n, _, ok := randomAccess(enumerator); ok {
+		enumerator.Dispose()
+		return n, nil
+	}
+	return Fold(enumerator, 0, func(acc int, item T) (int, error) {
+		return acc + 1, nil
+	})
+}
+
+// Min returns the smallest element.
+func Min[T constraints.Ordered](enumerator Enumerator[T]) (T, error) {
+	return Reduce(enumerator, func(acc T, item T) (T, error) {
+		if item < acc {
+			return item, nil
+		}
+		return acc, nil
+	})
+}
+
+// Max returns the largest element.
+func Max[T constraints.Ordered](enumerator Enumerator[T]) (T, error) {
+	return Reduce(enumerator, func(acc T, item T) (T, error) {
+		if item > acc {
+			return item, nil
+		}
+		return acc, nil
+	})
+}
+
+// MinBy returns the first element with the smallest key.
+func MinBy[T any, K constraints.Ordered](enumerator Enumerator[T], key func(T) (K, error)) (T, error) {
+	return extremeBy(enumerator, key, func(candidate, best K) bool { return candidate < best })
+}
+
+// MaxBy returns the first element with the largest key.
+func MaxBy[T any, K constraints.Ordered](enumerator Enumerator[T], key func(T) (K, error)) (T, error) {
+	return extremeBy(enumerator, key, func(candidate, best K) bool { return candidate > best })
+}
+
+func extremeBy[T any, K constraints.Ordered](enumerator Enumerator[T], key func(T) (K, error), better func(candidate, best K) bool) (T, error) {
+	type keyed struct {
+		item T
+		key  K
+	}
+
+	best, err := Reduce(Map(enumerator, func(item T) (keyed, error) {
+		k, err := key(item)
+		return keyed{item: item, key: k}, err
+	}), func(acc keyed, item keyed) (keyed, error) {
+		if better(item.key, acc.key) {
+			return item, nil
+		}
+		return acc, nil
+	})
+	return best.item, err
+}
+
+// Average returns the arithmetic mean of the selected values.
+func Average[T any, N Number](enumerator Enumerator[T], selector func(item T) (N, error)) (float64, error) {
+	type total struct {
+		sum   float64
+		count int
+	}
+
+	result, err := Fold(enumerator, total{}, func(acc total, item T) (total, error) {
+		value, err := selector(item)
+		if err != nil {
+			return acc, err
+		}
+		return total{sum: acc.sum + float64(value), count: acc.count + 1}, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if result.count == 0 {
+		return 0, ErrNoElements
+	}
+	return result.sum / float64(result.count), nil
+}
+
+// Any reports whether any element satisfies the predicate. It stops at the
+// first match.
+func Any[T any](enumerator Enumerator[T], predicate func(T) bool) (bool, error) {
+	defer enumerator.Dispose()
+	for enumerator.MoveNext() {
+		item, err := enumerator.Current()
+		if err != nil {
+			return false, err
+		}
+		if predicate(item) {
+			return true, nil
+		}
+	}
+	return false, enumerator.Err()
+}
+
+// Every reports whether all elements satisfy the predicate. It stops at the
+// first mismatch and returns true for an empty enumerator. It is the "All"
+// aggregate; that name is taken by the iterator adapter All.
+func Every[T any](enumerator Enumerator[T], predicate func(T) bool) (bool, error) {
+	found, err := Any(enumerator, func(item T) bool { return !predicate(item) })
+	if err != nil {
+		return false, err
+	}
+	return !found, nil
+}
+
+// First returns the first element or ErrNoElements.
+func First[T any](enumerator Enumerator[T]) (T, error) {
+	defer enumerator.Dispose()
+	if enumerator.MoveNext() {
+		return enumerator.Current()
+	}
+
+	var zero T
+	if err := enumerator.Err(); err != nil {
+		return zero, err
+	}
+	return zero, ErrNoElements
+}
+
+// Last returns the last element or ErrNoElements.
+func Last[T any](enumerator Enumerator[T]) (T, error) {
+	if n, at, ok := randomAccess(enumerator); ok {
+		defer enumerator.Dispose()
+		if n == 0 {
+			var zero T
+			return zero, ErrNoElements
+		}
+		return at(n - 1), nil
+	}
+	return Reduce(enumerator, func(acc T, item T) (T, error) {
+		return item, nil
+	})
+}
+
+// ElementAt returns the element at the zero-based index or ErrOutOfRange.
+func ElementAt[T any](enumerator Enumerator[T], index int) (T, error) {
+	defer enumerator.Dispose()
+	var zero T
+	if index < 0 {
+		return zero, ErrOutOfRange
+	}
+
+	if n, at, ok := randomAccess(enumerator); ok {
+		if index >= n {
+			return zero, ErrOutOfRange
+		}
+		return at(index), nil
+	}
+
+	for i := 0; enumerator.MoveNext(); i++ {
+		item, err := enumerator.Current()
+		if err != nil {
+			return zero, err
+		}
+		if i == index {
+			return item, nil
+		}
+	}
+
+	if err := enumerator.Err(); err != nil {
+		return zero, err
+	}
+	return zero, ErrOutOfRange
+}
+
+// Single returns the only element. It returns ErrNoElements when the
+// enumerator is empty and ErrMoreThanOneElement when it has more than one.
+func Single[T any](enumerator Enumerator[T]) (T, error) {
+	defer enumerator.Dispose()
+	var zero T
+	if !enumerator.MoveNext() {
+		if err := enumerator.Err(); err != nil {
+			return zero, err
+		}
+		return zero, ErrNoElements
+	}
+
+	item, err := enumerator.Current()
+	if err != nil {
+		return zero, err
+	}
+
+	if enumerator.MoveNext() {
+		return zero, ErrMoreThanOneElement
+	}
+	if err := enumerator.Err(); err != nil {
+		return zero, err
+	}
+	return item, nil
+}
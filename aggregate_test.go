@@ -0,0 +1,157 @@
+package enumerators_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFold(t *testing.T) {
+	// Act
+	result, err := enumerators.Fold(enumerators.Slice([]int{1, 2, 3}), "", func(acc string, i int) (string, error) {
+		return acc + string(rune('0'+i)), nil
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "123", result)
+}
+
+func TestReduce_Empty(t *testing.T) {
+	// Act
+	_, err := enumerators.Reduce(enumerators.Empty[int](), func(acc, i int) (int, error) { return acc + i, nil })
+
+	// Assert
+	assert.ErrorIs(t, err, enumerators.ErrNoElements)
+}
+
+func TestCount(t *testing.T) {
+	// Act
+	result, err := enumerators.Count(enumerators.Slice([]int{4, 5, 6}))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 3, result)
+}
+
+func TestMinMax(t *testing.T) {
+	// Act
+	smallest, minErr := enumerators.Min(enumerators.Slice([]int{4, 2, 6}))
+	largest, maxErr := enumerators.Max(enumerators.Slice([]int{4, 2, 6}))
+
+	// Assert
+	assert.NoError(t, minErr)
+	assert.NoError(t, maxErr)
+	assert.Equal(t, 2, smallest)
+	assert.Equal(t, 6, largest)
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	// Arrange
+	items := []Item{{Weight: 3}, {Weight: 1}, {Weight: 5}, {Weight: 1}}
+	weight := func(i Item) (int, error) { return i.Weight, nil }
+
+	// Act
+	smallest, minErr := enumerators.MinBy(enumerators.Slice(items), weight)
+	largest, maxErr := enumerators.MaxBy(enumerators.Slice(items), weight)
+
+	// Assert
+	assert.NoError(t, minErr)
+	assert.NoError(t, maxErr)
+	assert.Equal(t, Item{Weight: 1}, smallest)
+	assert.Equal(t, Item{Weight: 5}, largest)
+}
+
+func TestAverage(t *testing.T) {
+	// Act
+	result, err := enumerators.Average(enumerators.Slice([]int{1, 2, 3, 4}), func(i int) (int, error) { return i, nil })
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 2.5, result)
+}
+
+func TestAnyEvery(t *testing.T) {
+	// Act
+	anyEven, anyErr := enumerators.Any(enumerators.Slice([]int{1, 3, 4}), func(i int) bool { return i%2 == 0 })
+	allOdd, allErr := enumerators.Every(enumerators.Slice([]int{1, 3, 4}), func(i int) bool { return i%2 == 1 })
+
+	// Assert
+	assert.NoError(t, anyErr)
+	assert.NoError(t, allErr)
+	assert.True(t, anyEven)
+	assert.False(t, allOdd)
+}
+
+func TestAny_StopsAndDisposes(t *testing.T) {
+	// Arrange
+	pulled := 0
+	disposed := false
+	source := enumerators.GenerateAndDispose(func() (int, bool, error) {
+		pulled++
+		return pulled, true, nil
+	}, func() { disposed = true })
+
+	// Act
+	found, err := enumerators.Any(source, func(i int) bool { return i == 3 })
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, 3, pulled)
+	assert.True(t, disposed)
+}
+
+func TestFirstLast(t *testing.T) {
+	// Act
+	first, firstErr := enumerators.First(enumerators.Slice([]int{7, 8, 9}))
+	last, lastErr := enumerators.Last(enumerators.Slice([]int{7, 8, 9}))
+	_, emptyErr := enumerators.First(enumerators.Empty[int]())
+
+	// Assert
+	assert.NoError(t, firstErr)
+	assert.NoError(t, lastErr)
+	assert.Equal(t, 7, first)
+	assert.Equal(t, 9, last)
+	assert.ErrorIs(t, emptyErr, enumerators.ErrNoElements)
+}
+
+func TestElementAt(t *testing.T) {
+	// Act
+	item, err := enumerators.ElementAt(enumerators.Slice([]int{7, 8, 9}), 1)
+	_, rangeErr := enumerators.ElementAt(enumerators.Slice([]int{7, 8, 9}), 3)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 8, item)
+	assert.ErrorIs(t, rangeErr, enumerators.ErrOutOfRange)
+}
+
+func TestSingle(t *testing.T) {
+	// Act
+	item, err := enumerators.Single(enumerators.Slice([]int{7}))
+	_, manyErr := enumerators.Single(enumerators.Slice([]int{7, 8}))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 7, item)
+	assert.ErrorIs(t, manyErr, enumerators.ErrMoreThanOneElement)
+}
+
+func TestAggregate_BubbleError(t *testing.T) {
+	// Arrange
+	source := enumerators.Map(enumerators.Slice([]int{1, 2, 3}), func(i int) (int, error) {
+		if i == 2 {
+			return 0, errors.New("bubble error")
+		}
+		return i, nil
+	})
+
+	// Act
+	_, err := enumerators.Max(source)
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+}
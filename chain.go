@@ -9,6 +9,7 @@ func Chain[T any](enumerators ...Enumerator[T]) Enumerator[T] {
 type chainEnumerator[T any] struct {
 	enumerators []Enumerator[T]
 	index       int
+	err         error
 }
 
 // Current implements Enumerator.
@@ -30,6 +31,9 @@ func (c *chainEnumerator[T]) Dispose() {
 
 // Err implements Enumerator.
 func (c *chainEnumerator[T]) Err() error {
+	if c.err != nil {
+		return c.err
+	}
 	if c.index >= len(c.enumerators) {
 		return nil
 	}
@@ -42,6 +46,10 @@ func (c *chainEnumerator[T]) MoveNext() bool {
 		if c.enumerators[c.index].MoveNext() {
 			return true
 		}
+		if err := c.enumerators[c.index].Err(); err != nil {
+			c.err = err
+			return false
+		}
 		c.index++ // Move to the next enumerator
 	}
 	return false
@@ -1,6 +1,7 @@
 package enumerators_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/fgrzl/enumerators"
@@ -20,3 +21,21 @@ func TestChainEnumerator_Err(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, []int{1, 2, 3, 4, 5, 6}, slice)
 }
+
+func TestChainEnumerator_BubbleErrorFromFirst(t *testing.T) {
+	// Arrange
+	pulled := false
+	second := enumerators.Generate(func() (int, bool, error) {
+		pulled = true
+		return 1, false, nil
+	})
+
+	// Act
+	chainEnum := enumerators.Chain(enumerators.Error[int](errors.New("bubble error")), second)
+
+	// Assert
+	slice, err := enumerators.ToSlice(chainEnum)
+	assert.EqualError(t, err, "bubble error")
+	assert.Empty(t, slice)
+	assert.False(t, pulled)
+}
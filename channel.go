@@ -22,6 +22,9 @@ func (e *ChannelEnumerator[T]) MoveNext() bool {
 		select {
 		case <-e.doneCh:
 			return false
+		case <-e.context.Done():
+			e.err = e.context.Err()
+			return false
 		case err, ok := <-e.errCh:
 			if ok {
 				e.err = err
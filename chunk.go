@@ -32,6 +32,7 @@ func (e *chunkEnumerator[T, TSize]) MoveNext() bool {
 
 	if e.currentChunk == nil {
 		if !e.base.MoveNext() {
+			e.err = e.base.Err()
 			e.exhausted = true
 			return false
 		}
@@ -72,6 +73,9 @@ func (e *chunkEnumerator[T, TSize]) Current() (Enumerator[T], error) {
 }
 
 func (e *chunkEnumerator[T, TSize]) Err() error {
+	if e.currentChunk == nil {
+		return e.err
+	}
 	return e.currentChunk.err
 }
 
@@ -205,3 +205,30 @@ func TestChunk_BubbleError(t *testing.T) {
 
 	assert.Equal(t, expected, result)
 }
+
+func TestChunk_ErrOnEmptyInput(t *testing.T) {
+	// Arrange
+	chunks := enumerators.Chunk(enumerators.Empty[int](), 5, func(item int) (int, error) { return item, nil })
+
+	// Act
+	hasNext := chunks.MoveNext()
+
+	// Assert
+	assert.False(t, hasNext)
+	assert.NoError(t, chunks.Err())
+}
+
+func TestChunk_BubbleSourceErrorBeforeFirstItem(t *testing.T) {
+	// Arrange
+	source := enumerators.Map(enumerators.Slice([]int{1}), func(item int) (int, error) {
+		return 0, errors.New("source error")
+	})
+	chunks := enumerators.Chunk(source, 5, func(item int) (int, error) { return item, nil })
+
+	// Act
+	hasNext := chunks.MoveNext()
+
+	// Assert
+	assert.False(t, hasNext)
+	assert.EqualError(t, chunks.Err(), "source error")
+}
@@ -0,0 +1,204 @@
+package enumerators
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"golang.org/x/exp/constraints"
+)
+
+type timedItem[T any] struct {
+	item T
+	err  error
+}
+
+type timedChunkEnumerator[T any, TSize constraints.Ordered] struct {
+	base      Enumerator[T]
+	target    TSize
+	maxWait   time.Duration
+	compute   func(item T) (TSize, error)
+	clock     Clock
+	items     chan timedItem[T]
+	stop      chan struct{}
+	wg        sync.WaitGroup
+	start     sync.Once
+	dispose   sync.Once
+	carry     []T
+	carrySize TSize
+	// carryDue fires maxWait after the carried item was read, so a slow
+	// consumer does not push back its flush.
+	carryDue  <-chan time.Time
+	current   []T
+	err       error
+	exhausted bool
+}
+
+// MoveNext gathers the next chunk. A chunk is emitted when its size reaches
+// the target, when the next item would exceed the target, or when maxWait has
+// elapsed since the chunk's first item.
+func (e *timedChunkEnumerator[T, TSize]) MoveNext() bool {
+	if e.exhausted {
+		return false
+	}
+	e.start.Do(e.run)
+
+	chunk := e.carry
+	cumulative := e.carrySize
+	timeout := e.carryDue
+	e.carry = nil
+	e.carryDue = nil
+
+	if len(chunk) > 0 && cumulative >= e.target {
+		e.current = chunk
+		return true
+	}
+
+	for {
+		select {
+		case next, ok := <-e.items:
+			if !ok {
+				e.exhausted = true
+				if len(chunk) == 0 {
+					return false
+				}
+				e.current = chunk
+				return true
+			}
+
+			if next.err != nil {
+				e.err = next.err
+				e.exhausted = true
+				return false
+			}
+
+			size, err := e.compute(next.item)
+			if err != nil {
+				e.err = err
+				e.exhausted = true
+				return false
+			}
+
+			if len(chunk) > 0 && cumulative+size > e.target {
+				e.carry = []T{next.item}
+				e.carrySize = size
+				if size < e.target {
+					e.carryDue = e.clock.After(e.maxWait)
+				}
+				e.current = chunk
+				return true
+			}
+
+			chunk = append(chunk, next.item)
+			cumulative += size
+			if len(chunk) == 1 {
+				timeout = e.clock.After(e.maxWait)
+			}
+
+			if cumulative >= e.target {
+				e.current = chunk
+				return true
+			}
+
+		case <-timeout:
+			e.current = chunk
+			return true
+		}
+	}
+}
+
+func (e *timedChunkEnumerator[T, TSize]) Current() ([]T, error) {
+	return e.current, e.err
+}
+
+func (e *timedChunkEnumerator[T, TSize]) Err() error {
+	return e.err
+}
+
+// Dispose stops the background reader, waits for it to exit and then disposes
+// the source.
+func (e *timedChunkEnumerator[T, TSize]) Dispose() {
+	e.dispose.Do(func() {
+		e.exhausted = true
+		e.start.Do(func() {})
+		if e.stop != nil {
+			close(e.stop)
+			e.wg.Wait()
+		}
+		e.base.Dispose()
+	})
+}
+
+func (e *timedChunkEnumerator[T, TSize]) run() {
+	e.items = make(chan timedItem[T])
+	e.stop = make(chan struct{})
+	e.wg.Add(1)
+	go e.read()
+}
+
+// read is the only goroutine that touches the source enumerator.
+func (e *timedChunkEnumerator[T, TSize]) read() {
+	defer e.wg.Done()
+	defer close(e.items)
+
+	send := func(next timedItem[T]) bool {
+		select {
+		case e.items <- next:
+			return true
+		case <-e.stop:
+			return false
+		}
+	}
+
+	for e.base.MoveNext() {
+		item, err := e.base.Current()
+		if !send(timedItem[T]{item: item, err: err}) || err != nil {
+			return
+		}
+	}
+
+	if err := e.base.Err(); err != nil {
+		send(timedItem[T]{err: err})
+	}
+}
+
+// ChunkWithTimeout groups items into slices that are emitted when either the
+// size target is reached or maxWait elapses after the chunk's first item.
+// The source is pulled from a background goroutine so slow sources such as
+// Channel still flush on time.
+func ChunkWithTimeout[T any, TSize constraints.Ordered](
+	in Enumerator[T],
+	maxSize TSize,
+	maxWait time.Duration,
+	compute func(item T) (TSize, error),
+) Enumerator[[]T] {
+	return ChunkWithTimeoutAndClock(in, maxSize, maxWait, compute, SystemClock())
+}
+
+// ChunkWithTimeoutAndClock is ChunkWithTimeout with an injectable clock.
+func ChunkWithTimeoutAndClock[T any, TSize constraints.Ordered](
+	in Enumerator[T],
+	maxSize TSize,
+	maxWait time.Duration,
+	compute func(item T) (TSize, error),
+	clock Clock,
+) Enumerator[[]T] {
+	if in == nil {
+		return Empty[[]T]()
+	}
+	return &timedChunkEnumerator[T, TSize]{
+		base:    in,
+		target:  maxSize,
+		maxWait: maxWait,
+		compute: compute,
+		clock:   clock,
+	}
+}
+
+// ChunkByDuration groups items into slices that are emitted maxWait after
+// each chunk's first item.
+func ChunkByDuration[T any](in Enumerator[T], maxWait time.Duration) Enumerator[[]T] {
+	return ChunkWithTimeout(in, math.MaxInt, maxWait, func(item T) (int, error) {
+		return 1, nil
+	})
+}
@@ -0,0 +1,152 @@
+package enumerators_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	timers chan chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{timers: make(chan chan time.Time)}
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	timer := make(chan time.Time, 1)
+	c.timers <- timer
+	return timer
+}
+
+func TestChunkWithTimeout_BySize(t *testing.T) {
+	// Arrange
+	source := enumerators.Slice([]int{1, 2, 3, 4, 5, 6})
+	chunks := enumerators.ChunkWithTimeout(source, 5, time.Hour, func(item int) (int, error) { return item, nil })
+
+	// Act
+	result, err := enumerators.ToSlice(chunks)
+
+	// Assert
+	assert.NoError(t, err)
+	expected := [][]int{{1, 2}, {3}, {4}, {5}, {6}}
+	assert.Equal(t, expected, result)
+}
+
+func TestChunkWithTimeout_ByTime(t *testing.T) {
+	// Arrange
+	clock := newFakeClock()
+	source := enumerators.Channel[int](context.Background(), 0)
+	chunks := enumerators.ChunkWithTimeoutAndClock(source, 2, time.Second, func(item int) (int, error) { return 1, nil }, clock)
+	results := make(chan []int)
+	go func() {
+		defer close(results)
+		for chunks.MoveNext() {
+			chunk, _ := chunks.Current()
+			results <- chunk
+		}
+	}()
+
+	// Act & Assert
+	source.Publish(1)
+	timer := <-clock.timers
+	timer <- time.Now()
+	assert.Equal(t, []int{1}, <-results)
+
+	source.Publish(2)
+	<-clock.timers
+	source.Publish(3)
+	assert.Equal(t, []int{2, 3}, <-results)
+
+	source.Publish(4)
+	<-clock.timers
+	source.Complete()
+	assert.Equal(t, []int{4}, <-results)
+
+	_, open := <-results
+	assert.False(t, open)
+	assert.NoError(t, chunks.Err())
+	chunks.Dispose()
+}
+
+func TestChunkWithTimeout_DisposeWhileWaiting(t *testing.T) {
+	// Arrange
+	clock := newFakeClock()
+	source := enumerators.Slice([]int{1, 2, 3})
+	chunks := enumerators.ChunkWithTimeoutAndClock(source, 2, time.Second, func(item int) (int, error) { return 1, nil }, clock)
+	go func() {
+		for range clock.timers {
+		}
+	}()
+
+	// Act
+	assert.True(t, chunks.MoveNext())
+	chunks.Dispose()
+
+	// Assert
+	assert.False(t, chunks.MoveNext())
+	close(clock.timers)
+}
+
+func TestChunkByDuration(t *testing.T) {
+	// Arrange
+	source := enumerators.Slice([]int{1, 2, 3})
+	chunks := enumerators.ChunkByDuration(source, time.Hour)
+
+	// Act
+	result, err := enumerators.ToSlice(chunks)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{1, 2, 3}}, result)
+}
+
+func TestChunkWithTimeout_CarriedItemTimerStartsWhenRead(t *testing.T) {
+	// Arrange
+	clock := newFakeClock()
+	source := enumerators.Channel[int](context.Background(), 0)
+	chunks := enumerators.ChunkWithTimeoutAndClock(source, 3, time.Second, func(item int) (int, error) { return item, nil }, clock)
+	proceed := make(chan struct{})
+	results := make(chan []int)
+	go func() {
+		defer close(results)
+		for chunks.MoveNext() {
+			chunk, _ := chunks.Current()
+			results <- chunk
+			<-proceed
+		}
+	}()
+	nextTimer := func() chan time.Time {
+		select {
+		case timer := <-clock.timers:
+			return timer
+		case <-time.After(time.Second):
+			t.Fatal("timer was not started")
+			return nil
+		}
+	}
+
+	// Act & Assert
+	source.Publish(2)
+	nextTimer()
+	source.Publish(2)
+
+	// The overflowing item's timer starts as soon as it is read, before the
+	// slow consumer asks for the next chunk.
+	carried := nextTimer()
+	assert.Equal(t, []int{2}, <-results)
+	carried <- time.Now()
+	proceed <- struct{}{}
+	assert.Equal(t, []int{2}, <-results)
+
+	source.Complete()
+	proceed <- struct{}{}
+	_, open := <-results
+	assert.False(t, open)
+	assert.NoError(t, chunks.Err())
+	chunks.Dispose()
+}
@@ -0,0 +1,20 @@
+package enumerators
+
+import "time"
+
+// Clock abstracts timers so time-based operators can be tested
+// deterministically.
+type Clock interface {
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// SystemClock returns a Clock backed by the time package.
+func SystemClock() Clock {
+	return systemClock{}
+}
@@ -0,0 +1,126 @@
+package enumerators
+
+import (
+	"context"
+
+	"golang.org/x/exp/constraints"
+)
+
+type contextEnumerator[T any] struct {
+	ctx  context.Context
+	base Enumerator[T]
+	err  error
+}
+
+// MoveNext stops as soon as the context is done without pulling from the
+// underlying enumerator.
+func (e *contextEnumerator[T]) MoveNext() bool {
+	if e.err != nil {
+		return false
+	}
+
+	if err := e.ctx.Err(); err != nil {
+		e.err = err
+		return false
+	}
+
+	if !e.base.MoveNext() {
+		if err := e.ctx.Err(); err != nil {
+			e.err = err
+		}
+		return false
+	}
+	return true
+}
+
+func (e *contextEnumerator[T]) Current() (T, error) {
+	if e.err != nil {
+		var zero T
+		return zero, e.err
+	}
+	return e.base.Current()
+}
+
+func (e *contextEnumerator[T]) Err() error {
+	if e.err != nil {
+		return e.err
+	}
+	return e.base.Err()
+}
+
+func (e *contextEnumerator[T]) Dispose() {
+	e.base.Dispose()
+}
+
+// WithContext binds an enumerator to a context. Once the context is done,
+// MoveNext returns false and Err reports ctx.Err().
+func WithContext[T any](ctx context.Context, enumerator Enumerator[T]) Enumerator[T] {
+	return &contextEnumerator[T]{
+		ctx:  ctx,
+		base: enumerator,
+	}
+}
+
+// MapCtx creates a mapped enumerator that observes the context.
+func MapCtx[T any, U any](ctx context.Context, enumerator Enumerator[T], mapper func(context.Context, T) (U, error)) Enumerator[U] {
+	return WithContext(ctx, Map(WithContext(ctx, enumerator), func(item T) (U, error) {
+		return mapper(ctx, item)
+	}))
+}
+
+// FilterCtx creates a filtered enumerator that observes the context.
+func FilterCtx[T any](ctx context.Context, enumerator Enumerator[T], filter func(context.Context, T) bool) Enumerator[T] {
+	return WithContext(ctx, Filter(WithContext(ctx, enumerator), func(item T) bool {
+		return filter(ctx, item)
+	}))
+}
+
+// FilterMapCtx creates a filter-mapped enumerator that observes the context.
+func FilterMapCtx[TIn any, TOut any](ctx context.Context, enumerator Enumerator[TIn], apply func(context.Context, TIn) (TOut, bool, error)) Enumerator[TOut] {
+	return WithContext(ctx, FilterMap(WithContext(ctx, enumerator), func(item TIn) (TOut, bool, error) {
+		return apply(ctx, item)
+	}))
+}
+
+// FlatMapCtx creates a flat-mapped enumerator that observes the context.
+// Inner enumerators are bound to the context as well.
+func FlatMapCtx[T any, U any](ctx context.Context, enumerator Enumerator[T], mapper func(context.Context, T) Enumerator[U]) Enumerator[U] {
+	return WithContext(ctx, FlatMap(WithContext(ctx, enumerator), func(item T) Enumerator[U] {
+		return WithContext(ctx, mapper(ctx, item))
+	}))
+}
+
+// ChunkCtx creates a chunked enumerator that stops pulling from the source
+// once the context is done.
+func ChunkCtx[T any, TSize constraints.Ordered](
+	ctx context.Context,
+	in Enumerator[T],
+	target TSize,
+	compute func(item T) (TSize, error),
+) Enumerator[Enumerator[T]] {
+	return WithContext(ctx, Chunk(WithContext(ctx, in), target, compute))
+}
+
+// GroupCtx creates a grouping enumerator that stops pulling from the source
+// once the context is done.
+func GroupCtx[T any, G comparable](
+	ctx context.Context,
+	in Enumerator[T],
+	compute func(item T) (G, error),
+) Enumerator[*Grouping[T, G]] {
+	return WithContext(ctx, Group(WithContext(ctx, in), compute))
+}
+
+// InterleaveCtx creates an interleaved enumerator whose sources stop being
+// pulled once the context is done.
+func InterleaveCtx[T any, TOrdered constraints.Ordered](
+	ctx context.Context,
+	enumerators []Enumerator[T],
+	key func(T) TOrdered,
+) Enumerator[T] {
+	bound := make([]Enumerator[T], len(enumerators))
+	for i, enumerator := range enumerators {
+		bound[i] = WithContext(ctx, enumerator)
+	}
+	return WithContext(ctx, Interleave(bound, key))
+}
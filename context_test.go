@@ -0,0 +1,235 @@
+package enumerators_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithContext_Cancelled(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	pulled := 0
+	source := enumerators.Generate(func() (int, bool, error) {
+		pulled++
+		return pulled, true, nil
+	})
+	bound := enumerators.WithContext(ctx, source)
+
+	// Act
+	assert.True(t, bound.MoveNext())
+	cancel()
+	hasNext := bound.MoveNext()
+
+	// Assert
+	assert.False(t, hasNext)
+	assert.ErrorIs(t, bound.Err(), context.Canceled)
+	assert.Equal(t, 1, pulled)
+}
+
+func TestWithContext_PassesThrough(t *testing.T) {
+	// Arrange
+	bound := enumerators.WithContext(context.Background(), enumerators.Slice([]int{1, 2, 3}))
+
+	// Act
+	result, err := enumerators.ToSlice(bound)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, result)
+}
+
+func TestMapCtx_StopsOnCancel(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	pulled := 0
+	source := enumerators.Generate(func() (int, bool, error) {
+		pulled++
+		return pulled, true, nil
+	})
+	mapped := enumerators.MapCtx(ctx, source, func(ctx context.Context, i int) (int, error) {
+		if i == 3 {
+			cancel()
+		}
+		return i * 10, nil
+	})
+
+	// Act
+	result, err := enumerators.ToSlice(mapped)
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []int{10, 20, 30}, result)
+	assert.Equal(t, 3, pulled)
+}
+
+func TestFilterCtx_StopsOnCancel(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	pulled := 0
+	source := enumerators.Generate(func() (int, bool, error) {
+		pulled++
+		return pulled, true, nil
+	})
+	filtered := enumerators.FilterCtx(ctx, source, func(ctx context.Context, i int) bool {
+		if i == 5 {
+			cancel()
+		}
+		return false
+	})
+
+	// Act
+	result, err := enumerators.ToSlice(filtered)
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, result)
+	assert.Equal(t, 5, pulled)
+}
+
+func TestChannel_CancelledContext(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	source := enumerators.Channel[int](ctx, 1)
+
+	// Act
+	cancel()
+	hasNext := source.MoveNext()
+
+	// Assert
+	assert.False(t, hasNext)
+	assert.ErrorIs(t, source.Err(), context.Canceled)
+}
+
+func TestFlatMapCtx_StopsInnerOnCancel(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	outerPulled := 0
+	innerPulled := 0
+	source := enumerators.Generate(func() (int, bool, error) {
+		outerPulled++
+		return outerPulled, true, nil
+	})
+	flattened := enumerators.FlatMapCtx(ctx, source, func(ctx context.Context, i int) enumerators.Enumerator[int] {
+		return enumerators.Generate(func() (int, bool, error) {
+			innerPulled++
+			if innerPulled == 3 {
+				cancel()
+			}
+			return i*100 + innerPulled, true, nil
+		})
+	})
+
+	// Act
+	result, err := enumerators.ToSlice(flattened)
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []int{101, 102, 103}, result)
+	assert.Equal(t, 1, outerPulled)
+	assert.Equal(t, 3, innerPulled)
+}
+
+func TestFilterMapCtx_StopsOnCancel(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	pulled := 0
+	source := enumerators.Generate(func() (int, bool, error) {
+		pulled++
+		return pulled, true, nil
+	})
+	evens := enumerators.FilterMapCtx(ctx, source, func(ctx context.Context, i int) (string, bool, error) {
+		if i == 4 {
+			cancel()
+		}
+		return strconv.Itoa(i), i%2 == 0, nil
+	})
+
+	// Act
+	result, err := enumerators.ToSlice(evens)
+
+	// Assert
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []string{"2", "4"}, result)
+	assert.Equal(t, 4, pulled)
+}
+
+func TestChunkCtx_StopsOnCancel(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	pulled := 0
+	source := enumerators.Generate(func() (int, bool, error) {
+		pulled++
+		if pulled == 4 {
+			cancel()
+		}
+		return pulled, true, nil
+	})
+	chunks := enumerators.ChunkCtx(ctx, source, 2, func(item int) (int, error) { return 1, nil })
+
+	// Act
+	hasFirst := chunks.MoveNext()
+	first, _ := chunks.Current()
+	firstItems, firstErr := enumerators.ToSlice(first)
+	hasSecond := chunks.MoveNext()
+	second, _ := chunks.Current()
+	secondItems, secondErr := enumerators.ToSlice(second)
+	hasThird := chunks.MoveNext()
+
+	// Assert
+	assert.True(t, hasFirst)
+	assert.NoError(t, firstErr)
+	assert.Equal(t, []int{1, 2}, firstItems)
+	assert.True(t, hasSecond)
+	assert.ErrorIs(t, secondErr, context.Canceled)
+	assert.Equal(t, []int{3, 4}, secondItems)
+	assert.False(t, hasThird)
+	assert.ErrorIs(t, chunks.Err(), context.Canceled)
+	assert.Equal(t, 4, pulled)
+}
+
+func TestGroupCtx_StopsOnCancel(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	groupings := enumerators.GroupCtx(ctx, enumerators.Slice([]int{1, 1, 2, 2, 3}), func(item int) (int, error) {
+		return item, nil
+	})
+
+	// Act
+	hasFirst := groupings.MoveNext()
+	first, _ := groupings.Current()
+	cancel()
+	hasSecond := groupings.MoveNext()
+
+	// Assert
+	assert.True(t, hasFirst)
+	assert.Equal(t, 1, first.Key)
+	assert.False(t, hasSecond)
+	assert.ErrorIs(t, groupings.Err(), context.Canceled)
+}
+
+func TestInterleaveCtx_StopsOnCancel(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	odds := enumerators.Slice([]int{1, 3, 5, 7})
+	evens := enumerators.Slice([]int{2, 4, 6, 8})
+	merged := enumerators.InterleaveCtx(ctx, []enumerators.Enumerator[int]{odds, evens}, func(item int) int { return item })
+
+	// Act
+	var result []int
+	for merged.MoveNext() {
+		item, _ := merged.Current()
+		result = append(result, item)
+		if item == 3 {
+			cancel()
+		}
+	}
+
+	// Assert
+	assert.ErrorIs(t, merged.Err(), context.Canceled)
+	assert.Equal(t, []int{1, 2, 3}, result)
+}
@@ -0,0 +1,203 @@
+package enumerators
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// CSVOptions configures the CSV reader and writer. Zero values use the
+// encoding/csv defaults.
+type CSVOptions struct {
+	// Comma is the field delimiter. Zero uses ','.
+	Comma rune
+	// Comment, if set, marks lines to ignore when reading.
+	Comment rune
+	// FieldsPerRecord follows csv.Reader: 0 requires every record to match
+	// the first, a negative value allows any number of fields.
+	FieldsPerRecord int
+	// LazyQuotes allows quotes in unquoted fields when reading.
+	LazyQuotes bool
+	// TrimLeadingSpace ignores leading white space in fields when reading.
+	TrimLeadingSpace bool
+}
+
+// CSVError describes a value that could not be converted while reading CSV
+// into structs.
+type CSVError struct {
+	// Row is the one-based record number, counting the header as row 1.
+	Row int
+	// Column is the header name of the field.
+	Column string
+	Err    error
+}
+
+func (e *CSVError) Error() string {
+	return fmt.Sprintf("csv: row %d, column %q: %v", e.Row, e.Column, e.Err)
+}
+
+func (e *CSVError) Unwrap() error {
+	return e.Err
+}
+
+type csvEnumerator struct {
+	reader   io.Reader
+	csv      *csv.Reader
+	current  []string
+	err      error
+	done     bool
+	disposed bool
+}
+
+func (e *csvEnumerator) MoveNext() bool {
+	if e.done || e.disposed {
+		return false
+	}
+
+	record, err := e.csv.Read()
+	if err != nil {
+		if !errors.Is(err, io.EOF) {
+			e.err = err
+		}
+		e.done = true
+		return false
+	}
+	e.current = record
+	return true
+}
+
+func (e *csvEnumerator) Current() ([]string, error) {
+	return e.current, e.err
+}
+
+func (e *csvEnumerator) Err() error {
+	return e.err
+}
+
+// Dispose closes the reader if it implements io.Closer.
+func (e *csvEnumerator) Dispose() {
+	if e.disposed {
+		return
+	}
+	e.disposed = true
+	if closer, ok := e.reader.(io.Closer); ok {
+		closer.Close()
+	}
+}
+
+// ReadCSV yields every record of the reader, including any header row.
+// Parse errors are reported by Err. The reader is closed on Dispose when it
+// implements io.Closer.
+func ReadCSV(r io.Reader, options CSVOptions) Enumerator[[]string] {
+	reader := csv.NewReader(r)
+	if options.Comma != 0 {
+		reader.Comma = options.Comma
+	}
+	reader.Comment = options.Comment
+	reader.FieldsPerRecord = options.FieldsPerRecord
+	reader.LazyQuotes = options.LazyQuotes
+	reader.TrimLeadingSpace = options.TrimLeadingSpace
+	return &csvEnumerator{reader: r, csv: reader}
+}
+
+// ReadCSVStructs reads the first record as a header and maps every following
+// record onto a T. Columns are matched to fields by their `csv` tag or field
+// name; unmatched columns and fields are ignored. Conversion failures are
+// reported as *CSVError.
+func ReadCSVStructs[T any](r io.Reader, options CSVOptions) Enumerator[T] {
+	fields, err := structFields(reflect.TypeFor[T](), "csv")
+	if err != nil {
+		if closer, ok := r.(io.Closer); ok {
+			closer.Close()
+		}
+		return Error[T](err)
+	}
+
+	byName := make(map[string][]int, len(fields))
+	for _, field := range fields {
+		byName[field.name] = field.index
+	}
+
+	var header []string
+	var columns [][]int
+	row := 0
+	return FilterMap(ReadCSV(r, options), func(record []string) (T, bool, error) {
+		var item T
+		row++
+		if header == nil {
+			header = record
+			columns = make([][]int, len(record))
+			for i, name := range record {
+				columns[i] = byName[name]
+			}
+			return item, false, nil
+		}
+
+		value := reflect.ValueOf(&item).Elem()
+		for i, text := range record {
+			if i >= len(columns) || columns[i] == nil {
+				continue
+			}
+			if err := parseInto(value.FieldByIndex(columns[i]), text); err != nil {
+				return item, false, &CSVError{Row: row, Column: header[i], Err: err}
+			}
+		}
+		return item, true, nil
+	})
+}
+
+// WriteCSV writes every record. The enumerator is disposed when done.
+func WriteCSV(w io.Writer, enumerator Enumerator[[]string], options CSVOptions) error {
+	defer enumerator.Dispose()
+	writer := csv.NewWriter(w)
+	if options.Comma != 0 {
+		writer.Comma = options.Comma
+	}
+
+	for enumerator.MoveNext() {
+		record, err := enumerator.Current()
+		if err != nil {
+			return err
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	if err := enumerator.Err(); err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteCSVStructs writes a header row followed by one record per item, using
+// the same `csv` tags as ReadCSVStructs. The enumerator is disposed when done.
+func WriteCSVStructs[T any](w io.Writer, enumerator Enumerator[T], options CSVOptions) error {
+	fields, err := structFields(reflect.TypeFor[T](), "csv")
+	if err != nil {
+		enumerator.Dispose()
+		return err
+	}
+
+	header := make([]string, len(fields))
+	for i, field := range fields {
+		header[i] = field.name
+	}
+
+	records := Map(enumerator, func(item T) ([]string, error) {
+		value := reflect.ValueOf(item)
+		record := make([]string, len(fields))
+		for i, field := range fields {
+			text, err := formatValue(value.FieldByIndex(field.index))
+			if err != nil {
+				return nil, fmt.Errorf("csv: column %q: %w", field.name, err)
+			}
+			record[i] = text
+		}
+		return record, nil
+	})
+	return WriteCSV(w, Chain(Slice([][]string{header}), records), options)
+}
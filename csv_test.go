@@ -0,0 +1,119 @@
+package enumerators_test
+
+import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+type stock struct {
+	SKU      string  `csv:"sku"`
+	Quantity int     `csv:"qty"`
+	Price    float64 `csv:"price"`
+	Active   bool
+	Note     string `csv:"-"`
+}
+
+func TestReadCSV(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.ReadCSV(strings.NewReader("a,b\n\"1,5\",2\n"), enumerators.CSVOptions{}))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"a", "b"}, {"1,5", "2"}}, result)
+}
+
+func TestReadCSVStructs(t *testing.T) {
+	// Arrange
+	input := "price;sku;ignored;qty;Active\n1.5;A-1;x;3;true\n2;B-2;y;;false\n"
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.ReadCSVStructs[stock](strings.NewReader(input), enumerators.CSVOptions{Comma: ';'}))
+
+	// Assert
+	assert.NoError(t, err)
+	expected := []stock{
+		{SKU: "A-1", Quantity: 3, Price: 1.5, Active: true},
+		{SKU: "B-2", Quantity: 0, Price: 2},
+	}
+	assert.Equal(t, expected, result)
+}
+
+func TestReadCSVStructs_ConversionError(t *testing.T) {
+	// Arrange
+	input := "sku,qty\nA-1,3\nB-2,lots\n"
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.ReadCSVStructs[stock](strings.NewReader(input), enumerators.CSVOptions{}))
+
+	// Assert
+	var csvErr *enumerators.CSVError
+	assert.True(t, errors.As(err, &csvErr))
+	assert.Equal(t, 3, csvErr.Row)
+	assert.Equal(t, "qty", csvErr.Column)
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+	assert.Len(t, result, 1)
+}
+
+func TestWriteCSVStructs_RoundTrip(t *testing.T) {
+	// Arrange
+	var buffer bytes.Buffer
+	items := []stock{{SKU: "A-1", Quantity: 3, Price: 1.5, Active: true}}
+
+	// Act
+	err := enumerators.WriteCSVStructs(&buffer, enumerators.Slice(items), enumerators.CSVOptions{})
+	encoded := buffer.String()
+	decoded, decodeErr := enumerators.ToSlice(enumerators.ReadCSVStructs[stock](&buffer, enumerators.CSVOptions{}))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, decodeErr)
+	assert.Equal(t, "sku,qty,price,Active\nA-1,3,1.5,true\n", encoded)
+	assert.Equal(t, items, decoded)
+}
+
+type shipment struct {
+	ID     string     `csv:"id"`
+	At     *time.Time `csv:"at"`
+	Weight *int       `csv:"weight"`
+}
+
+func TestWriteCSVStructs_RoundTripNilPointers(t *testing.T) {
+	// Arrange
+	var buffer bytes.Buffer
+	at := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	weight := 7
+	items := []shipment{
+		{ID: "a"},
+		{ID: "b", At: &at, Weight: &weight},
+	}
+
+	// Act
+	err := enumerators.WriteCSVStructs(&buffer, enumerators.Slice(items), enumerators.CSVOptions{})
+	encoded := buffer.String()
+	decoded, decodeErr := enumerators.ToSlice(enumerators.ReadCSVStructs[shipment](&buffer, enumerators.CSVOptions{}))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, decodeErr)
+	assert.Equal(t, "id,at,weight\na,,\nb,2024-05-01T12:00:00Z,7\n", encoded)
+	assert.Equal(t, items, decoded)
+}
+
+func TestWriteCSV_BubbleError(t *testing.T) {
+	// Arrange
+	var buffer bytes.Buffer
+	records := enumerators.Chain(enumerators.Slice([][]string{{"a"}}), enumerators.Error[[]string](errors.New("bubble error")))
+
+	// Act
+	err := enumerators.WriteCSV(&buffer, records, enumerators.CSVOptions{})
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+}
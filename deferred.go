@@ -0,0 +1,45 @@
+package enumerators
+
+// deferredEnumerator materialises its items on the first call to MoveNext and
+// then yields them in order.
+type deferredEnumerator[T any] struct {
+	base    Disposable
+	load    func() ([]T, error)
+	items   []T
+	cursor  int
+	current T
+	err     error
+	loaded  bool
+}
+
+func (e *deferredEnumerator[T]) MoveNext() bool {
+	if !e.loaded {
+		e.loaded = true
+		e.items, e.err = e.load()
+		if e.err != nil {
+			return false
+		}
+	}
+
+	if e.cursor >= len(e.items) {
+		return false
+	}
+	e.current = e.items[e.cursor]
+	e.cursor++
+	return true
+}
+
+func (e *deferredEnumerator[T]) Current() (T, error) {
+	return e.current, e.err
+}
+
+func (e *deferredEnumerator[T]) Err() error {
+	return e.err
+}
+
+func (e *deferredEnumerator[T]) Dispose() {
+	if e.base != nil {
+		e.base.Dispose()
+	}
+	e.items = nil
+}
@@ -0,0 +1,46 @@
+package enumerators
+
+// Distinct yields each item the first time it is seen.
+func Distinct[T comparable](enumerator Enumerator[T]) Enumerator[T] {
+	return DistinctBy(enumerator, func(item T) (T, error) {
+		return item, nil
+	})
+}
+
+// DistinctBy yields the first item seen for each key. Every key is kept in
+// memory for the lifetime of the enumerator.
+func DistinctBy[T any, K comparable](enumerator Enumerator[T], key func(item T) (K, error)) Enumerator[T] {
+	seen := make(map[K]struct{})
+	return FilterMap(enumerator, func(item T) (T, bool, error) {
+		k, err := key(item)
+		if err != nil {
+			return item, false, err
+		}
+
+		if _, ok := seen[k]; ok {
+			return item, false, nil
+		}
+		seen[k] = struct{}{}
+		return item, true, nil
+	})
+}
+
+// DistinctUntilChanged suppresses items whose key equals the key of the item
+// just before them. Only the previous key is kept, so memory use is constant.
+func DistinctUntilChanged[T any, K comparable](enumerator Enumerator[T], key func(item T) (K, error)) Enumerator[T] {
+	var previous K
+	started := false
+	return FilterMap(enumerator, func(item T) (T, bool, error) {
+		k, err := key(item)
+		if err != nil {
+			return item, false, err
+		}
+
+		if started && k == previous {
+			return item, false, nil
+		}
+		started = true
+		previous = k
+		return item, true, nil
+	})
+}
@@ -0,0 +1,56 @@
+package enumerators_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistinct(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Distinct(enumerators.Slice([]int{3, 1, 3, 2, 1})))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 1, 2}, result)
+}
+
+func TestDistinctBy(t *testing.T) {
+	// Arrange
+	source := enumerators.Slice([]string{"apple", "avocado", "bob", "banana"})
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.DistinctBy(source, func(s string) (byte, error) { return s[0], nil }))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"apple", "bob"}, result)
+}
+
+func TestDistinctUntilChanged_AfterInterleave(t *testing.T) {
+	// Arrange
+	shards := []enumerators.Enumerator[int]{
+		enumerators.Slice([]int{1, 3, 5}),
+		enumerators.Slice([]int{1, 2, 5}),
+	}
+	merged := enumerators.Interleave(shards, func(i int) int { return i })
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.DistinctUntilChanged(merged, func(i int) (int, error) { return i, nil }))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 5}, result)
+}
+
+func TestDistinctUntilChanged_BubbleError(t *testing.T) {
+	// Act
+	_, err := enumerators.ToSlice(enumerators.DistinctUntilChanged(enumerators.Slice([]int{1, 2}), func(i int) (int, error) {
+		return 0, errors.New("bubble error")
+	}))
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+}
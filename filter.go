@@ -10,6 +10,7 @@ type filterEnumerator[T any] struct {
 func (e *filterEnumerator[T]) MoveNext() bool {
 	for {
 		if !e.base.MoveNext() {
+			e.err = e.base.Err()
 			return false
 		}
 
@@ -10,6 +10,7 @@ type filterMapper[TIn any, TOut any] struct {
 func (e *filterMapper[TIn, TOut]) MoveNext() bool {
 	for {
 		if !e.base.MoveNext() {
+			e.err = e.base.Err()
 			return false
 		}
 
@@ -16,8 +16,13 @@ func (e *flatMapEnumerator[T, U]) MoveNext() bool {
 			if e.current.MoveNext() {
 				return true
 			}
+			err := e.current.Err()
 			e.current.Dispose()
 			e.current = nil
+			if err != nil {
+				e.err = err
+				return false
+			}
 		}
 
 		// Move to the next item in the base enumerator
@@ -29,6 +34,7 @@ func (e *flatMapEnumerator[T, U]) MoveNext() bool {
 		// Get the next enumerator from the mapper
 		item, err := e.base.Current()
 		if err != nil {
+			e.err = err
 			return false
 		}
 		e.current = e.mapper(item)
@@ -102,7 +102,7 @@ func (e *GroupEnumerator[T, G]) Current() (*Grouping[T, G], error) {
 
 func (e *GroupEnumerator[T, G]) Err() error {
 	if e.currentChunk == nil {
-		return errors.New("no current chunk")
+		return e.err
 	}
 	return e.currentChunk.Enumerator.err
 }
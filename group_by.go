@@ -0,0 +1,78 @@
+package enumerators
+
+// GroupBy groups all items by key regardless of their order. The source is
+// fully consumed on the first call to MoveNext and groups are yielded in the
+// order their keys were first seen.
+func GroupBy[T any, G comparable](
+	in Enumerator[T],
+	compute func(item T) (G, error),
+) Enumerator[*GroupingSlice[T, G]] {
+	return &deferredEnumerator[*GroupingSlice[T, G]]{
+		base: in,
+		load: func() ([]*GroupingSlice[T, G], error) {
+			var groups []*GroupingSlice[T, G]
+			index := make(map[G]*GroupingSlice[T, G])
+			for in.MoveNext() {
+				item, err := in.Current()
+				if err != nil {
+					return nil, err
+				}
+
+				key, err := compute(item)
+				if err != nil {
+					return nil, err
+				}
+
+				group, ok := index[key]
+				if !ok {
+					group = &GroupingSlice[T, G]{Group: key}
+					index[key] = group
+					groups = append(groups, group)
+				}
+				group.Items = append(group.Items, item)
+			}
+			return groups, in.Err()
+		},
+	}
+}
+
+// GroupByInto groups all items by key and folds each group with the
+// aggregator instead of keeping its items. Each accumulator starts at the zero
+// value of A. Results are yielded in the order their keys were first seen.
+func GroupByInto[T any, G comparable, A any](
+	in Enumerator[T],
+	compute func(item T) (G, error),
+	aggregator func(acc A, item T) (A, error),
+) Enumerator[*KeyValuePair[G, A]] {
+	return &deferredEnumerator[*KeyValuePair[G, A]]{
+		base: in,
+		load: func() ([]*KeyValuePair[G, A], error) {
+			var groups []*KeyValuePair[G, A]
+			index := make(map[G]*KeyValuePair[G, A])
+			for in.MoveNext() {
+				item, err := in.Current()
+				if err != nil {
+					return nil, err
+				}
+
+				key, err := compute(item)
+				if err != nil {
+					return nil, err
+				}
+
+				group, ok := index[key]
+				if !ok {
+					group = &KeyValuePair[G, A]{Key: key}
+					index[key] = group
+					groups = append(groups, group)
+				}
+
+				group.Value, err = aggregator(group.Value, item)
+				if err != nil {
+					return nil, err
+				}
+			}
+			return groups, in.Err()
+		},
+	}
+}
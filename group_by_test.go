@@ -0,0 +1,63 @@
+package enumerators_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupBy(t *testing.T) {
+	// Arrange
+	source := enumerators.Slice([]int{3, 1, 2, 3, 1, 3})
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.GroupBy(source, func(i int) (int, error) { return i, nil }))
+
+	// Assert
+	assert.NoError(t, err)
+	expected := []*enumerators.GroupingSlice[int, int]{
+		{Items: []int{3, 3, 3}, Group: 3},
+		{Items: []int{1, 1}, Group: 1},
+		{Items: []int{2}, Group: 2},
+	}
+	assert.Equal(t, expected, result)
+}
+
+func TestGroupBy_BubbleError(t *testing.T) {
+	// Arrange
+	source := enumerators.Slice([]int{1, 2, 3})
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.GroupBy(source, func(i int) (int, error) {
+		if i == 3 {
+			return 0, errors.New("bubble error")
+		}
+		return i, nil
+	}))
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+	assert.Empty(t, result)
+}
+
+func TestGroupByInto(t *testing.T) {
+	// Arrange
+	source := enumerators.Slice([]string{"apple", "bob", "avocado", "banana", "cherry"})
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.GroupByInto(source,
+		func(s string) (byte, error) { return s[0], nil },
+		func(acc int, s string) (int, error) { return acc + len(s), nil },
+	))
+
+	// Assert
+	assert.NoError(t, err)
+	expected := []*enumerators.KeyValuePair[byte, int]{
+		{Key: 'a', Value: 12},
+		{Key: 'b', Value: 9},
+		{Key: 'c', Value: 6},
+	}
+	assert.Equal(t, expected, result)
+}
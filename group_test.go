@@ -2,6 +2,7 @@ package enumerators_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/fgrzl/enumerators"
@@ -71,6 +72,33 @@ func TestGroupOverEmptyClosedChannel(t *testing.T) {
 	assert.Equal(t, expected, result)
 }
 
+func TestGroup_ErrOnEmptyInput(t *testing.T) {
+	// Arrange
+	groupings := setupSourceAndGroupings(enumerators.Empty[int]())
+
+	// Act
+	hasNext := groupings.MoveNext()
+
+	// Assert
+	assert.False(t, hasNext)
+	assert.NoError(t, groupings.Err())
+}
+
+func TestGroup_BubbleSourceErrorBeforeFirstItem(t *testing.T) {
+	// Arrange
+	source := enumerators.Map(enumerators.Slice([]int{1}), func(item int) (int, error) {
+		return 0, errors.New("source error")
+	})
+	groupings := setupSourceAndGroupings(source)
+
+	// Act
+	hasNext := groupings.MoveNext()
+
+	// Assert
+	assert.False(t, hasNext)
+	assert.EqualError(t, groupings.Err(), "source error")
+}
+
 func setupSourceAndGroupings(source enumerators.Enumerator[int]) enumerators.Enumerator[*enumerators.Grouping[int, int]] {
 	return enumerators.Group(source, func(i int) (int, error) { return i, nil })
 }
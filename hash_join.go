@@ -0,0 +1,171 @@
+package enumerators
+
+import "errors"
+
+type hashJoinEnumerator[P any, B any, K comparable, U any] struct {
+	probe    Enumerator[P]
+	build    Enumerator[B]
+	probeKey func(P) (K, error)
+	buildKey func(B) (K, error)
+	combine  func(probe P, build B, matched bool) (U, error)
+	kind     JoinKind
+	table    map[K][]B
+	matches  []B
+	active   P
+	current  U
+	err      error
+	built    bool
+	done     bool
+	disposed bool
+}
+
+func (e *hashJoinEnumerator[P, B, K, U]) MoveNext() bool {
+	if e.done {
+		return false
+	}
+
+	if !e.built {
+		e.built = true
+		if err := e.load(); err != nil {
+			return e.fail(err)
+		}
+	}
+
+	for {
+		if len(e.matches) > 0 {
+			match := e.matches[0]
+			e.matches = e.matches[1:]
+			current, err := e.combine(e.active, match, true)
+			if err != nil {
+				return e.fail(err)
+			}
+			e.current = current
+			return true
+		}
+
+		if !e.probe.MoveNext() {
+			e.done = true
+			e.err = e.probe.Err()
+			return false
+		}
+
+		item, err := e.probe.Current()
+		if err != nil {
+			return e.fail(err)
+		}
+
+		key, err := e.probeKey(item)
+		if err != nil {
+			return e.fail(err)
+		}
+
+		e.active = item
+		e.matches = e.table[key]
+		if len(e.matches) == 0 && e.kind.keepsLeft() {
+			var zero B
+			current, err := e.combine(item, zero, false)
+			if err != nil {
+				return e.fail(err)
+			}
+			e.current = current
+			return true
+		}
+	}
+}
+
+// load materialises the build side and releases it as soon as it is read.
+func (e *hashJoinEnumerator[P, B, K, U]) load() error {
+	defer e.build.Dispose()
+	e.table = make(map[K][]B)
+	for e.build.MoveNext() {
+		item, err := e.build.Current()
+		if err != nil {
+			return err
+		}
+
+		key, err := e.buildKey(item)
+		if err != nil {
+			return err
+		}
+		e.table[key] = append(e.table[key], item)
+	}
+	return e.build.Err()
+}
+
+func (e *hashJoinEnumerator[P, B, K, U]) fail(err error) bool {
+	e.err = err
+	e.done = true
+	return false
+}
+
+func (e *hashJoinEnumerator[P, B, K, U]) Current() (U, error) {
+	return e.current, e.err
+}
+
+func (e *hashJoinEnumerator[P, B, K, U]) Err() error {
+	return e.err
+}
+
+func (e *hashJoinEnumerator[P, B, K, U]) Dispose() {
+	if e.disposed {
+		return
+	}
+	e.disposed = true
+	e.probe.Dispose()
+	if !e.built {
+		e.build.Dispose()
+	}
+	e.table = nil
+	e.matches = nil
+}
+
+// HashJoin streams the probe side against a hash table built from the build
+// side. The build side is fully read on the first call to MoveNext, so it
+// should be the smaller input. Each probe row yields one result per matching
+// build row; with LeftOuterJoin an unmatched probe row yields one result with
+// matched set to false. Only InnerJoin and LeftOuterJoin are supported.
+func HashJoin[P any, B any, K comparable, U any](
+	probe Enumerator[P],
+	build Enumerator[B],
+	probeKey func(P) (K, error),
+	buildKey func(B) (K, error),
+	combine func(probe P, build B, matched bool) (U, error),
+	kind JoinKind,
+) Enumerator[U] {
+	if kind != InnerJoin && kind != LeftOuterJoin {
+		probe.Dispose()
+		build.Dispose()
+		return Error[U](errors.New("hash join supports only inner and left outer joins"))
+	}
+	return &hashJoinEnumerator[P, B, K, U]{
+		probe:    probe,
+		build:    build,
+		probeKey: probeKey,
+		buildKey: buildKey,
+		combine:  combine,
+		kind:     kind,
+	}
+}
+
+// LookupJoin joins the probe side against an in-memory map.
+func LookupJoin[P any, B any, K comparable, U any](
+	probe Enumerator[P],
+	lookup map[K][]B,
+	probeKey func(P) (K, error),
+	combine func(probe P, build B, matched bool) (U, error),
+	kind JoinKind,
+) Enumerator[U] {
+	if kind != InnerJoin && kind != LeftOuterJoin {
+		probe.Dispose()
+		return Error[U](errors.New("lookup join supports only inner and left outer joins"))
+	}
+	return &hashJoinEnumerator[P, B, K, U]{
+		probe:    probe,
+		build:    Empty[B](),
+		probeKey: probeKey,
+		combine:  combine,
+		kind:     kind,
+		table:    lookup,
+		built:    true,
+	}
+}
@@ -0,0 +1,89 @@
+package enumerators_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func describe(p row, b row, matched bool) (string, error) {
+	if !matched {
+		return p.Name + ":-", nil
+	}
+	return p.Name + ":" + b.Name, nil
+}
+
+func rowKey(r row) (int, error) {
+	return r.Key, nil
+}
+
+func TestHashJoin_Inner(t *testing.T) {
+	// Arrange
+	probe := enumerators.Slice([]row{{2, "p2"}, {1, "p1"}, {3, "p3"}})
+	build := enumerators.Slice([]row{{2, "b2a"}, {3, "b3"}, {2, "b2b"}})
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.HashJoin(probe, build, rowKey, rowKey, describe, enumerators.InnerJoin))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"p2:b2a", "p2:b2b", "p3:b3"}, result)
+}
+
+func TestHashJoin_LeftOuter(t *testing.T) {
+	// Arrange
+	probe := enumerators.Slice([]row{{2, "p2"}, {1, "p1"}})
+	build := enumerators.Slice([]row{{2, "b2"}})
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.HashJoin(probe, build, rowKey, rowKey, describe, enumerators.LeftOuterJoin))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"p2:b2", "p1:-"}, result)
+}
+
+func TestHashJoin_BuildFailsMidway(t *testing.T) {
+	// Arrange
+	probeDisposed, buildDisposed := false, false
+	probe := enumerators.Cleanup(enumerators.Slice([]row{{1, "p1"}}), func() { probeDisposed = true })
+	failing := enumerators.Map(enumerators.Slice([]row{{1, "b1"}, {2, "b2"}}), func(r row) (row, error) {
+		if r.Key == 2 {
+			return r, errors.New("build failed")
+		}
+		return r, nil
+	})
+	build := enumerators.Cleanup(failing, func() { buildDisposed = true })
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.HashJoin(probe, build, rowKey, rowKey, describe, enumerators.InnerJoin))
+
+	// Assert
+	assert.EqualError(t, err, "build failed")
+	assert.Empty(t, result)
+	assert.True(t, probeDisposed)
+	assert.True(t, buildDisposed)
+}
+
+func TestHashJoin_UnsupportedKind(t *testing.T) {
+	// Act
+	_, err := enumerators.ToSlice(enumerators.HashJoin(enumerators.Empty[row](), enumerators.Empty[row](), rowKey, rowKey, describe, enumerators.FullOuterJoin))
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestLookupJoin(t *testing.T) {
+	// Arrange
+	probe := enumerators.Slice([]row{{1, "p1"}, {2, "p2"}})
+	lookup := map[int][]row{2: {{2, "b2"}}}
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.LookupJoin(probe, lookup, rowKey, describe, enumerators.LeftOuterJoin))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"p1:-", "p2:b2"}, result)
+}
@@ -2,53 +2,134 @@ package enumerators
 
 import (
 	"container/heap"
+	"sync"
 
 	"golang.org/x/exp/constraints"
 )
 
 // interleaveEnumerator represents the merged enumerator
-type interleaveEnumerator[T any, TOrdered constraints.Ordered] struct {
+type interleaveEnumerator[T any] struct {
 	enumerators []Enumerator[T]
-	key         func(T) TOrdered
-	queue       *priorityQueue[T, TOrdered]
+	queue       *priorityQueue[T]
 	current     T
 	err         error
+	pending     error
+	primed      bool
+	concurrent  bool
 }
 
-func (e *interleaveEnumerator[T, TOrdered]) MoveNext() bool {
+func (e *interleaveEnumerator[T]) MoveNext() bool {
 
-	if e.queue.Len() == 0 {
+	if !e.primed {
+		e.primed = true
+		if e.concurrent {
+			e.primeConcurrently()
+		} else {
+			e.prime()
+		}
+	}
+
+	// A source failed while refilling the queue; report it now that the item
+	// popped before the failure has been yielded.
+	if e.pending != nil {
+		e.err = e.pending
+		e.pending = nil
+	}
+
+	if e.err != nil || e.queue == nil || e.queue.Len() == 0 {
 		return false
 	}
 
 	// Pop the next item from the queue
-	top := heap.Pop(e.queue).(queueItem[T, TOrdered])
+	top := heap.Pop(e.queue).(queueItem[T])
 	position := top.position
 	e.current = top.item
 
-	// Advance the enumerator and add its next item to the queue, if available
-	if e.enumerators[position].MoveNext() {
-		nextItem, err := e.enumerators[position].Current()
-		if err == nil {
-			heap.Push(e.queue, queueItem[T, TOrdered]{
-				position: position,
-				item:     nextItem,
-				key:      e.key,
-			})
+	// Advance the enumerator and add its next item to the queue, if available.
+	// The popped item is still yielded if the source fails.
+	if err := e.push(position); err != nil {
+		e.pending = err
+	}
+	return true
+}
+
+// push advances the enumerator at position and queues its next item. It
+// returns the enumerator's error if it fails.
+func (e *interleaveEnumerator[T]) push(position int) error {
+	enumerator := e.enumerators[position]
+	if !enumerator.MoveNext() {
+		return enumerator.Err()
+	}
+
+	item, err := enumerator.Current()
+	if err != nil {
+		return err
+	}
+
+	heap.Push(e.queue, queueItem[T]{
+		position: position,
+		item:     item,
+	})
+	return nil
+}
+
+// prime fetches the first item of every enumerator in turn.
+func (e *interleaveEnumerator[T]) prime() {
+	for i := range e.enumerators {
+		if err := e.push(i); err != nil {
+			e.err = err
+			return
 		}
 	}
+}
 
-	return true
+// primeConcurrently fetches the first item of every enumerator in its own
+// goroutine. Later fetches happen on the caller's goroutine as usual.
+func (e *interleaveEnumerator[T]) primeConcurrently() {
+	type first struct {
+		item T
+		ok   bool
+		err  error
+	}
+
+	firsts := make([]first, len(e.enumerators))
+	var wg sync.WaitGroup
+	wg.Add(len(e.enumerators))
+	for i, enumerator := range e.enumerators {
+		go func() {
+			defer wg.Done()
+			if !enumerator.MoveNext() {
+				firsts[i].err = enumerator.Err()
+				return
+			}
+			firsts[i].item, firsts[i].err = enumerator.Current()
+			firsts[i].ok = firsts[i].err == nil
+		}()
+	}
+	wg.Wait()
+
+	for i, f := range firsts {
+		if f.err != nil {
+			e.err = f.err
+			return
+		}
+		if f.ok {
+			heap.Push(e.queue, queueItem[T]{
+				position: i,
+				item:     f.item,
+			})
+		}
+	}
 }
 
-func (e *interleaveEnumerator[T, TOrdered]) Current() (T, error) {
+func (e *interleaveEnumerator[T]) Current() (T, error) {
 	return e.current, e.err
 }
-func (e *interleaveEnumerator[T, TOrdered]) Err() error {
+func (e *interleaveEnumerator[T]) Err() error {
 	return e.err
 }
 
-func (e *interleaveEnumerator[T, TOrdered]) Dispose() {
+func (e *interleaveEnumerator[T]) Dispose() {
 	for _, enumerator := range e.enumerators {
 		enumerator.Dispose()
 	}
@@ -56,70 +137,140 @@ func (e *interleaveEnumerator[T, TOrdered]) Dispose() {
 }
 
 // queueItem wraps an entry along with its originating stream index
-type queueItem[T any, TOrdered constraints.Ordered] struct {
+type queueItem[T any] struct {
 	position int
 	item     T
-	key      func(T) TOrdered
 }
 
-// priorityQueue implements heap.Interface for queueItem based on the key ordering
-type priorityQueue[T any, TOrdered constraints.Ordered] []queueItem[T, TOrdered]
+// priorityQueue implements heap.Interface for queueItem based on the less
+// function. Items that compare equal are ordered by their stream index so the
+// merge is deterministic.
+type priorityQueue[T any] struct {
+	items []queueItem[T]
+	less  func(a, b T) bool
+}
 
-func (q priorityQueue[T, TOrdered]) Len() int {
-	return len(q)
+func (q *priorityQueue[T]) Len() int {
+	return len(q.items)
 }
 
-func (q priorityQueue[T, TOrdered]) Less(i, j int) bool {
-	left := q[i]
-	right := q[j]
-	return left.key(left.item) < right.key(right.item)
+func (q *priorityQueue[T]) Less(i, j int) bool {
+	left := q.items[i]
+	right := q.items[j]
+	if q.less(left.item, right.item) {
+		return true
+	}
+	if q.less(right.item, left.item) {
+		return false
+	}
+	return left.position < right.position
 }
 
-func (q priorityQueue[T, TOrdered]) Swap(i, j int) {
-	q[i], q[j] = q[j], q[i]
+func (q *priorityQueue[T]) Swap(i, j int) {
+	q.items[i], q.items[j] = q.items[j], q.items[i]
 }
 
-func (q *priorityQueue[T, TOrdered]) Push(x interface{}) {
-	*q = append(*q, x.(queueItem[T, TOrdered]))
+func (q *priorityQueue[T]) Push(x interface{}) {
+	q.items = append(q.items, x.(queueItem[T]))
 }
 
-func (q *priorityQueue[T, TOrdered]) Pop() interface{} {
-	old := *q
+func (q *priorityQueue[T]) Pop() interface{} {
+	old := q.items
 	n := len(old)
 	item := old[n-1]
-	*q = old[:n-1]
+	q.items = old[:n-1]
 	return item
 }
 
 // Interleave creates a new interleave enumerator that merges multiple enumerators
-// based on the ordering provided by the key function.
+// based on the ordering provided by the key function. The key is computed once
+// per item.
 func Interleave[T any, TOrdered constraints.Ordered](
 	enumerators []Enumerator[T],
 	key func(T) TOrdered,
+) Enumerator[T] {
+	return interleaveByKey(enumerators, key, func(a, b TOrdered) bool { return a < b }, false)
+}
+
+// InterleaveDescending merges enumerators that are each sorted in descending
+// order of the key.
+func InterleaveDescending[T any, TOrdered constraints.Ordered](
+	enumerators []Enumerator[T],
+	key func(T) TOrdered,
+) Enumerator[T] {
+	return interleaveByKey(enumerators, key, func(a, b TOrdered) bool { return a > b }, false)
+}
+
+// InterleaveFunc merges enumerators that are each sorted according to less.
+// Items that compare equal are yielded in the order of their source index.
+// No source is read until the first call to MoveNext.
+//
+// If a source fails while being advanced, the item taken from it just before
+// is still yielded. Enumeration then stops: items already queued from other
+// sources are discarded and Err reports the error.
+func InterleaveFunc[T any](
+	enumerators []Enumerator[T],
+	less func(a, b T) bool,
+) Enumerator[T] {
+	return newInterleave(enumerators, less, false)
+}
+
+// InterleaveConcurrent is Interleave with the first item of every source
+// fetched concurrently, which hides the latency of remote sources. The key of
+// each source's first item is computed on that source's goroutine.
+func InterleaveConcurrent[T any, TOrdered constraints.Ordered](
+	enumerators []Enumerator[T],
+	key func(T) TOrdered,
+) Enumerator[T] {
+	return interleaveByKey(enumerators, key, func(a, b TOrdered) bool { return a < b }, true)
+}
+
+// InterleaveFuncConcurrent is InterleaveFunc with the first item of every
+// source fetched concurrently. Each source is only touched by one goroutine at
+// a time.
+func InterleaveFuncConcurrent[T any](
+	enumerators []Enumerator[T],
+	less func(a, b T) bool,
+) Enumerator[T] {
+	return newInterleave(enumerators, less, true)
+}
+
+// interleaveByKey pairs every item with its key as it is read, so the heap
+// compares cached keys instead of calling key on every comparison.
+func interleaveByKey[T any, TOrdered constraints.Ordered](
+	enumerators []Enumerator[T],
+	key func(T) TOrdered,
+	less func(a, b TOrdered) bool,
+	concurrent bool,
+) Enumerator[T] {
+	keyed := make([]Enumerator[Pair[T, TOrdered]], len(enumerators))
+	for i, enumerator := range enumerators {
+		keyed[i] = Map(enumerator, func(item T) (Pair[T, TOrdered], error) {
+			return Pair[T, TOrdered]{First: item, Second: key(item)}, nil
+		})
+	}
+
+	merged := newInterleave(keyed, func(a, b Pair[T, TOrdered]) bool {
+		return less(a.Second, b.Second)
+	}, concurrent)
+	return Map(merged, func(pair Pair[T, TOrdered]) (T, error) {
+		return pair.First, nil
+	})
+}
+
+func newInterleave[T any](
+	enumerators []Enumerator[T],
+	less func(a, b T) bool,
+	concurrent bool,
 ) Enumerator[T] {
 	// Handle the edge case where no enumerators are provided
 	if len(enumerators) == 0 {
 		return Empty[T]()
 	}
 
-	// Initialize the priority queue
-	q := &priorityQueue[T, TOrdered]{}
-	for i, enumerator := range enumerators {
-		if enumerator.MoveNext() {
-			item, err := enumerator.Current()
-			if err == nil {
-				heap.Push(q, queueItem[T, TOrdered]{
-					position: i,
-					item:     item,
-					key:      key,
-				})
-			}
-		}
-	}
-
-	return &interleaveEnumerator[T, TOrdered]{
+	return &interleaveEnumerator[T]{
 		enumerators: enumerators,
-		key:         key,
-		queue:       q,
+		queue:       &priorityQueue[T]{less: less},
+		concurrent:  concurrent,
 	}
 }
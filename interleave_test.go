@@ -1,7 +1,10 @@
 package enumerators_test
 
 import (
+	"errors"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/fgrzl/enumerators"
 	"github.com/stretchr/testify/assert"
@@ -70,3 +73,171 @@ func TestInterleave_SingleEnumerator(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, expected, results, "Interleaved result does not match expected output")
 }
+
+type event struct {
+	Shard string
+	At    int
+	Seq   int
+}
+
+// TestInterleaveFunc_CompositeKey tests merging with a comparator over two fields.
+func TestInterleaveFunc_CompositeKey(t *testing.T) {
+	// Arrange
+	left := enumerators.Slice([]event{{"a", 1, 2}, {"a", 2, 1}})
+	right := enumerators.Slice([]event{{"b", 1, 1}, {"b", 2, 2}})
+
+	// Act
+	interleaved := enumerators.InterleaveFunc([]enumerators.Enumerator[event]{left, right}, func(a, b event) bool {
+		if a.At != b.At {
+			return a.At < b.At
+		}
+		return a.Seq < b.Seq
+	})
+
+	// Assert
+	results, err := enumerators.ToSlice(interleaved)
+	assert.NoError(t, err)
+	assert.Equal(t, []event{{"b", 1, 1}, {"a", 1, 2}, {"a", 2, 1}, {"b", 2, 2}}, results)
+}
+
+// TestInterleave_StableTies tests that equal keys are yielded by source index.
+func TestInterleave_StableTies(t *testing.T) {
+	// Arrange
+	first := enumerators.Slice([]event{{"a", 1, 0}, {"a", 2, 0}})
+	second := enumerators.Slice([]event{{"b", 1, 0}, {"b", 2, 0}})
+	third := enumerators.Slice([]event{{"c", 1, 0}})
+
+	// Act
+	interleaved := enumerators.Interleave([]enumerators.Enumerator[event]{third, first, second}, func(e event) int { return e.At })
+
+	// Assert
+	results, err := enumerators.ToSlice(interleaved)
+	assert.NoError(t, err)
+	shards := make([]string, len(results))
+	for i, r := range results {
+		shards[i] = r.Shard
+	}
+	assert.Equal(t, []string{"c", "a", "b", "a", "b"}, shards)
+}
+
+// TestInterleaveDescending tests merging descending inputs.
+func TestInterleaveDescending(t *testing.T) {
+	// Arrange
+	enumerator1 := enumerators.Slice([]int{9, 5, 1})
+	enumerator2 := enumerators.Slice([]int{8, 6, 2})
+
+	// Act
+	interleaved := enumerators.InterleaveDescending([]enumerators.Enumerator[int]{enumerator1, enumerator2}, func(item int) int { return item })
+
+	// Assert
+	results, err := enumerators.ToSlice(interleaved)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{9, 8, 6, 5, 2, 1}, results)
+}
+
+// TestInterleave_PropagatesError tests that a failing source stops the merge
+// without dropping the item it yielded before failing. The 3 already queued
+// from the healthy source is discarded.
+func TestInterleave_PropagatesError(t *testing.T) {
+	// Arrange
+	healthy := enumerators.Slice([]int{1, 3, 5, 7})
+	failing := enumerators.Map(enumerators.Slice([]int{2, 4, 6}), func(i int) (int, error) {
+		if i == 4 {
+			return 0, errors.New("shard failed")
+		}
+		return i, nil
+	})
+
+	// Act
+	interleaved := enumerators.Interleave([]enumerators.Enumerator[int]{healthy, failing}, func(item int) int { return item })
+
+	// Assert
+	results, err := enumerators.ToSlice(interleaved)
+	assert.EqualError(t, err, "shard failed")
+	assert.Equal(t, []int{1, 2}, results)
+}
+
+// TestInterleave_KeyOncePerItem tests that the key is computed once per item
+// rather than on every heap comparison.
+func TestInterleave_KeyOncePerItem(t *testing.T) {
+	// Arrange
+	calls := 0
+	key := func(item int) int {
+		calls++
+		return item
+	}
+	sources := []enumerators.Enumerator[int]{
+		enumerators.Slice([]int{1, 4, 7, 10}),
+		enumerators.Slice([]int{2, 5, 8, 11}),
+		enumerators.Slice([]int{3, 6, 9, 12}),
+	}
+
+	// Act
+	results, err := enumerators.ToSlice(enumerators.Interleave(sources, key))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, results, 12)
+	assert.Equal(t, 12, calls)
+}
+
+// TestInterleave_Lazy tests that no source is read before the first MoveNext.
+func TestInterleave_Lazy(t *testing.T) {
+	// Arrange
+	pulled := 0
+	source := enumerators.Generate(func() (int, bool, error) {
+		pulled++
+		return pulled, pulled <= 2, nil
+	})
+
+	// Act
+	interleaved := enumerators.Interleave([]enumerators.Enumerator[int]{source}, func(item int) int { return item })
+
+	// Assert
+	assert.Equal(t, 0, pulled)
+	results, err := enumerators.ToSlice(interleaved)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, results)
+}
+
+// TestInterleaveConcurrent tests that first fetches run at the same time.
+func TestInterleaveConcurrent(t *testing.T) {
+	// Arrange
+	const shards = 3
+	var started sync.WaitGroup
+	started.Add(shards)
+	all := make(chan struct{})
+	go func() {
+		started.Wait()
+		close(all)
+	}()
+
+	sources := make([]enumerators.Enumerator[int], shards)
+	for i := range sources {
+		items := []int{i, i + shards}
+		index := 0
+		sources[i] = enumerators.Generate(func() (int, bool, error) {
+			if index == 0 {
+				started.Done()
+				select {
+				case <-all:
+				case <-time.After(time.Second):
+					return 0, true, errors.New("first fetches were not concurrent")
+				}
+			}
+			if index >= len(items) {
+				return 0, false, nil
+			}
+			index++
+			return items[index-1], true, nil
+		})
+	}
+
+	// Act
+	interleaved := enumerators.InterleaveConcurrent(sources, func(item int) int { return item })
+
+	// Assert
+	results, err := enumerators.ToSlice(interleaved)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5}, results)
+}
@@ -0,0 +1,103 @@
+package enumerators
+
+import (
+	"iter"
+	"sync"
+)
+
+// All returns a range-over-func iterator over the enumerator. Each item is
+// yielded with a nil error; if the enumerator fails, the error is yielded once
+// with a zero value and iteration stops. The enumerator is disposed when the
+// loop exits, including on break.
+func All[T any](enumerator Enumerator[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		defer enumerator.Dispose()
+		for enumerator.MoveNext() {
+			item, err := enumerator.Current()
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if !yield(item, nil) {
+				return
+			}
+		}
+		if err := enumerator.Err(); err != nil {
+			var zero T
+			yield(zero, err)
+		}
+	}
+}
+
+type seqEnumerator[T any] struct {
+	next    func() (T, bool, error)
+	stop    func()
+	current T
+	err     error
+	done    bool
+	once    sync.Once
+}
+
+func (e *seqEnumerator[T]) MoveNext() bool {
+	if e.done {
+		return false
+	}
+
+	item, ok, err := e.next()
+	if !ok {
+		e.done = true
+		return false
+	}
+
+	if err != nil {
+		e.err = err
+		e.done = true
+		return false
+	}
+
+	e.current = item
+	return true
+}
+
+func (e *seqEnumerator[T]) Current() (T, error) {
+	return e.current, e.err
+}
+
+func (e *seqEnumerator[T]) Err() error {
+	return e.err
+}
+
+// Dispose stops the underlying pull iterator.
+func (e *seqEnumerator[T]) Dispose() {
+	e.once.Do(func() {
+		e.done = true
+		e.stop()
+	})
+}
+
+// FromSeq wraps an iter.Seq as an enumerator. The sequence is pulled lazily
+// and Dispose stops it.
+func FromSeq[T any](seq iter.Seq[T]) Enumerator[T] {
+	next, stop := iter.Pull(seq)
+	return &seqEnumerator[T]{
+		next: func() (T, bool, error) {
+			item, ok := next()
+			return item, ok, nil
+		},
+		stop: stop,
+	}
+}
+
+// FromSeq2 wraps an iter.Seq2 of items and errors as an enumerator. The first
+// non-nil error ends the enumeration and is reported by Err.
+func FromSeq2[T any](seq iter.Seq2[T, error]) Enumerator[T] {
+	next, stop := iter.Pull2(seq)
+	return &seqEnumerator[T]{
+		next: func() (T, bool, error) {
+			item, err, ok := next()
+			return item, ok, err
+		},
+		stop: stop,
+	}
+}
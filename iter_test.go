@@ -0,0 +1,118 @@
+package enumerators_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAll(t *testing.T) {
+	// Arrange
+	source := enumerators.Slice([]int{1, 2, 3})
+
+	// Act
+	var result []int
+	for item, err := range enumerators.All(source) {
+		assert.NoError(t, err)
+		result = append(result, item)
+	}
+
+	// Assert
+	assert.Equal(t, []int{1, 2, 3}, result)
+}
+
+func TestAll_DisposesOnBreak(t *testing.T) {
+	// Arrange
+	disposed := false
+	source := enumerators.Cleanup(enumerators.Slice([]int{1, 2, 3}), func() { disposed = true })
+
+	// Act
+	for item := range enumerators.All[int](source) {
+		if item == 2 {
+			break
+		}
+	}
+
+	// Assert
+	assert.True(t, disposed)
+}
+
+func TestAll_YieldsError(t *testing.T) {
+	// Arrange
+	source := enumerators.Map(enumerators.Slice([]int{1, 2, 3}), func(i int) (int, error) {
+		if i == 2 {
+			return 0, errors.New("boom")
+		}
+		return i, nil
+	})
+
+	// Act
+	var result []int
+	var errs []error
+	for item, err := range enumerators.All(source) {
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		result = append(result, item)
+	}
+
+	// Assert
+	assert.Equal(t, []int{1}, result)
+	assert.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], "boom")
+}
+
+func TestFromSeq(t *testing.T) {
+	// Arrange
+	source := enumerators.FromSeq(slices.Values([]int{1, 2, 3}))
+
+	// Act
+	result, err := enumerators.ToSlice(source)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, result)
+}
+
+func TestFromSeq_DisposeStopsSequence(t *testing.T) {
+	// Arrange
+	stopped := false
+	seq := func(yield func(int) bool) {
+		defer func() { stopped = true }()
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	source := enumerators.FromSeq(seq)
+
+	// Act
+	assert.True(t, source.MoveNext())
+	source.Dispose()
+
+	// Assert
+	assert.True(t, stopped)
+	assert.False(t, source.MoveNext())
+}
+
+func TestFromSeq2_Error(t *testing.T) {
+	// Arrange
+	seq := func(yield func(int, error) bool) {
+		if !yield(1, nil) {
+			return
+		}
+		yield(0, errors.New("boom"))
+	}
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.FromSeq2(seq))
+
+	// Assert
+	assert.EqualError(t, err, "boom")
+	assert.Equal(t, []int{1}, result)
+}
@@ -0,0 +1,32 @@
+package enumerators
+
+// JoinKind selects which unmatched rows a join yields.
+type JoinKind int
+
+const (
+	// InnerJoin yields only rows that have a match on both sides.
+	InnerJoin JoinKind = iota
+	// LeftOuterJoin also yields left rows without a match.
+	LeftOuterJoin
+	// RightOuterJoin also yields right rows without a match.
+	RightOuterJoin
+	// FullOuterJoin yields unmatched rows from both sides.
+	FullOuterJoin
+)
+
+func (k JoinKind) keepsLeft() bool {
+	return k == LeftOuterJoin || k == FullOuterJoin
+}
+
+func (k JoinKind) keepsRight() bool {
+	return k == RightOuterJoin || k == FullOuterJoin
+}
+
+// Joined is a row produced by a join. HasLeft and HasRight report which sides
+// are present; a missing side holds its zero value.
+type Joined[L any, R any] struct {
+	Left     L
+	Right    R
+	HasLeft  bool
+	HasRight bool
+}
@@ -0,0 +1,199 @@
+package enumerators
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+type jsonArrayEnumerator[T any] struct {
+	reader   io.Reader
+	decoder  *json.Decoder
+	path     []string
+	current  T
+	err      error
+	started  bool
+	done     bool
+	disposed bool
+}
+
+func (e *jsonArrayEnumerator[T]) MoveNext() bool {
+	if e.done || e.disposed {
+		return false
+	}
+
+	if !e.started {
+		e.started = true
+		if err := e.seek(); err != nil {
+			return e.fail(err)
+		}
+	}
+
+	if !e.decoder.More() {
+		// consume the closing bracket so truncated input is reported
+		if _, err := e.decoder.Token(); err != nil {
+			return e.fail(err)
+		}
+		e.done = true
+		return false
+	}
+
+	var item T
+	if err := e.decoder.Decode(&item); err != nil {
+		return e.fail(err)
+	}
+	e.current = item
+	return true
+}
+
+// seek walks the document to the opening bracket of the array at path.
+func (e *jsonArrayEnumerator[T]) seek() error {
+	for _, key := range e.path {
+		if err := e.expect('{'); err != nil {
+			return err
+		}
+
+		for {
+			token, err := e.decoder.Token()
+			if err != nil {
+				return err
+			}
+			if token == json.Delim('}') {
+				return fmt.Errorf("json array: key %q not found", key)
+			}
+			if token == key {
+				break
+			}
+			if err := e.skip(); err != nil {
+				return err
+			}
+		}
+	}
+	return e.expect('[')
+}
+
+func (e *jsonArrayEnumerator[T]) expect(delim json.Delim) error {
+	token, err := e.decoder.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("json array: expected %v at offset %d, found %v", delim, e.decoder.InputOffset(), token)
+	}
+	return nil
+}
+
+// skip discards the next value, including nested objects and arrays.
+func (e *jsonArrayEnumerator[T]) skip() error {
+	depth := 0
+	for {
+		token, err := e.decoder.Token()
+		if err != nil {
+			return err
+		}
+		switch token {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
+
+func (e *jsonArrayEnumerator[T]) fail(err error) bool {
+	if errors.Is(err, io.EOF) {
+		err = io.ErrUnexpectedEOF
+	}
+	e.err = err
+	e.done = true
+	return false
+}
+
+func (e *jsonArrayEnumerator[T]) Current() (T, error) {
+	return e.current, e.err
+}
+
+func (e *jsonArrayEnumerator[T]) Err() error {
+	return e.err
+}
+
+// Dispose closes the reader if it implements io.Closer.
+func (e *jsonArrayEnumerator[T]) Dispose() {
+	if e.disposed {
+		return
+	}
+	e.disposed = true
+	if closer, ok := e.reader.(io.Closer); ok {
+		closer.Close()
+	}
+}
+
+// DecodeJSONArray yields the elements of a JSON array one at a time without
+// loading the document. The path lists the object keys leading to the array,
+// so DecodeJSONArray[T](r, "items") reads {"items":[...]}; with no path the
+// document itself must be an array. Content after the array is not read.
+// The reader is closed on Dispose when it implements io.Closer.
+func DecodeJSONArray[T any](r io.Reader, path ...string) Enumerator[T] {
+	return &jsonArrayEnumerator[T]{
+		reader:  r,
+		decoder: json.NewDecoder(r),
+		path:    path,
+	}
+}
+
+// EncodeJSONArray writes the items as a JSON array, one element at a time.
+// The path wraps the array in nested objects, mirroring DecodeJSONArray. The
+// enumerator is disposed when done.
+func EncodeJSONArray[T any](w io.Writer, enumerator Enumerator[T], path ...string) error {
+	defer enumerator.Dispose()
+
+	for _, key := range path {
+		name, err := json.Marshal(key)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "{%s:", name); err != nil {
+			return err
+		}
+	}
+
+	if _, err := io.WriteString(w, "["); err != nil {
+		return err
+	}
+	for i := 0; enumerator.MoveNext(); i++ {
+		item, err := enumerator.Current()
+		if err != nil {
+			return err
+		}
+
+		data, err := json.Marshal(item)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+	if err := enumerator.Err(); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, "]"); err != nil {
+		return err
+	}
+
+	for range path {
+		if _, err := io.WriteString(w, "}"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package enumerators_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeJSONArray_AtPath(t *testing.T) {
+	// Arrange
+	input := `{"meta":{"skip":[1,{"x":[2]}]},"data":{"count":2,"items":[{"id":1,"body":"a"},{"id":2,"body":"b"}]}}`
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.DecodeJSONArray[message](strings.NewReader(input), "data", "items"))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []message{{1, "a"}, {2, "b"}}, result)
+}
+
+func TestDecodeJSONArray_Root(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.DecodeJSONArray[int](strings.NewReader(`[1, 2, 3]`)))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, result)
+}
+
+func TestDecodeJSONArray_MissingPath(t *testing.T) {
+	// Act
+	_, err := enumerators.ToSlice(enumerators.DecodeJSONArray[int](strings.NewReader(`{"other":[1]}`), "items"))
+
+	// Assert
+	assert.EqualError(t, err, `json array: key "items" not found`)
+}
+
+func TestDecodeJSONArray_Truncated(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.DecodeJSONArray[int](strings.NewReader(`{"items":[1,2`), "items"))
+
+	// Assert
+	assert.Error(t, err)
+	assert.Equal(t, []int{1, 2}, result)
+}
+
+func TestEncodeJSONArray_RoundTrip(t *testing.T) {
+	// Arrange
+	var buffer bytes.Buffer
+	messages := []message{{1, "a"}, {2, "b"}}
+
+	// Act
+	err := enumerators.EncodeJSONArray(&buffer, enumerators.Slice(messages), "items")
+	encoded := buffer.String()
+	decoded, decodeErr := enumerators.ToSlice(enumerators.DecodeJSONArray[message](&buffer, "items"))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, decodeErr)
+	assert.Equal(t, `{"items":[{"id":1,"body":"a"},{"id":2,"body":"b"}]}`, encoded)
+	assert.Equal(t, messages, decoded)
+}
+
+func TestEncodeJSONArray_Empty(t *testing.T) {
+	// Arrange
+	var buffer bytes.Buffer
+
+	// Act
+	err := enumerators.EncodeJSONArray(&buffer, enumerators.Empty[int]())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, `[]`, buffer.String())
+}
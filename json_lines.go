@@ -0,0 +1,147 @@
+package enumerators
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// JSONLineError describes a malformed record in a JSON Lines stream.
+type JSONLineError struct {
+	// Line is the one-based line number of the record.
+	Line int
+	// Offset is the byte offset of the start of the record.
+	Offset int64
+	Err    error
+}
+
+func (e *JSONLineError) Error() string {
+	return fmt.Sprintf("jsonl: line %d (offset %d): %v", e.Line, e.Offset, e.Err)
+}
+
+func (e *JSONLineError) Unwrap() error {
+	return e.Err
+}
+
+// JSONLinesOptions configures DecodeJSONLinesWithOptions.
+type JSONLinesOptions struct {
+	ReaderOptions
+	// OnError, when set, receives every malformed record, which is then
+	// skipped instead of ending the enumeration.
+	OnError func(err *JSONLineError)
+}
+
+type jsonLinesEnumerator[T any] struct {
+	reader   io.Reader
+	scanner  *bufio.Scanner
+	onError  func(err *JSONLineError)
+	line     int
+	consumed int64
+	start    int64
+	current  T
+	err      error
+	disposed bool
+}
+
+func (e *jsonLinesEnumerator[T]) MoveNext() bool {
+	if e.disposed || e.err != nil {
+		return false
+	}
+
+	for e.scanner.Scan() {
+		e.line++
+		record := bytes.TrimSpace(e.scanner.Bytes())
+		if len(record) == 0 {
+			continue
+		}
+
+		var item T
+		if err := json.Unmarshal(record, &item); err != nil {
+			lineErr := &JSONLineError{Line: e.line, Offset: e.start, Err: err}
+			if e.onError != nil {
+				e.onError(lineErr)
+				continue
+			}
+			e.err = lineErr
+			return false
+		}
+
+		e.current = item
+		return true
+	}
+
+	e.err = e.scanner.Err()
+	return false
+}
+
+// split wraps bufio.ScanLines to track the offset of each line.
+func (e *jsonLinesEnumerator[T]) split(data []byte, atEOF bool) (int, []byte, error) {
+	advance, token, err := bufio.ScanLines(data, atEOF)
+	if token != nil {
+		e.start = e.consumed
+	}
+	e.consumed += int64(advance)
+	return advance, token, err
+}
+
+func (e *jsonLinesEnumerator[T]) Current() (T, error) {
+	return e.current, e.err
+}
+
+func (e *jsonLinesEnumerator[T]) Err() error {
+	return e.err
+}
+
+// Dispose closes the reader if it implements io.Closer.
+func (e *jsonLinesEnumerator[T]) Dispose() {
+	if e.disposed {
+		return
+	}
+	e.disposed = true
+	if closer, ok := e.reader.(io.Closer); ok {
+		closer.Close()
+	}
+}
+
+// DecodeJSONLines yields one T per line of a JSON Lines stream. Blank lines
+// are ignored. A malformed record ends the enumeration with a *JSONLineError.
+// The reader is closed on Dispose when it implements io.Closer.
+//
+// The stream is read line by line and each record is decoded on its own
+// rather than with a single json.Decoder. A Decoder cannot resume after a
+// syntax error, so this is what lets OnError skip a bad record, and it ties
+// every error to its line number and offset. Memory use stays bounded by the
+// longest line.
+func DecodeJSONLines[T any](r io.Reader) Enumerator[T] {
+	return DecodeJSONLinesWithOptions[T](r, JSONLinesOptions{})
+}
+
+// DecodeJSONLinesWithOptions is DecodeJSONLines with a configurable maximum
+// line size and an optional sink for malformed records.
+func DecodeJSONLinesWithOptions[T any](r io.Reader, options JSONLinesOptions) Enumerator[T] {
+	e := &jsonLinesEnumerator[T]{
+		reader:  r,
+		onError: options.OnError,
+	}
+	e.scanner = newLineScanner(r, e.split, options.ReaderOptions)
+	return e
+}
+
+// EncodeJSONLines writes every item as one JSON document per line. The
+// enumerator is disposed when done.
+func EncodeJSONLines[T any](w io.Writer, enumerator Enumerator[T]) error {
+	defer enumerator.Dispose()
+	encoder := json.NewEncoder(w)
+	for enumerator.MoveNext() {
+		item, err := enumerator.Current()
+		if err != nil {
+			return err
+		}
+		if err := encoder.Encode(item); err != nil {
+			return err
+		}
+	}
+	return enumerator.Err()
+}
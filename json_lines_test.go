@@ -0,0 +1,75 @@
+package enumerators_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+type message struct {
+	ID   int    `json:"id"`
+	Body string `json:"body"`
+}
+
+func TestDecodeJSONLines(t *testing.T) {
+	// Arrange
+	input := "{\"id\":1,\"body\":\"a\"}\n\n{\"id\":2,\"body\":\"b\"}\r\n"
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.DecodeJSONLines[message](strings.NewReader(input)))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []message{{1, "a"}, {2, "b"}}, result)
+}
+
+func TestDecodeJSONLines_Malformed(t *testing.T) {
+	// Arrange
+	input := "{\"id\":1}\n{\"id\":\n{\"id\":3}\n"
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.DecodeJSONLines[message](strings.NewReader(input)))
+
+	// Assert
+	var lineErr *enumerators.JSONLineError
+	assert.True(t, errors.As(err, &lineErr))
+	assert.Equal(t, 2, lineErr.Line)
+	assert.Equal(t, int64(9), lineErr.Offset)
+	assert.Equal(t, []message{{ID: 1}}, result)
+}
+
+func TestDecodeJSONLines_SkipsIntoSink(t *testing.T) {
+	// Arrange
+	input := "{\"id\":1}\nnot json\n{\"id\":3}\n"
+	var skipped []*enumerators.JSONLineError
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.DecodeJSONLinesWithOptions[message](strings.NewReader(input), enumerators.JSONLinesOptions{
+		OnError: func(err *enumerators.JSONLineError) { skipped = append(skipped, err) },
+	}))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []message{{ID: 1}, {ID: 3}}, result)
+	assert.Len(t, skipped, 1)
+	assert.Equal(t, 2, skipped[0].Line)
+}
+
+func TestEncodeJSONLines_RoundTrip(t *testing.T) {
+	// Arrange
+	var buffer bytes.Buffer
+	messages := []message{{1, "a"}, {2, "b"}}
+
+	// Act
+	err := enumerators.EncodeJSONLines(&buffer, enumerators.Slice(messages))
+	decoded, decodeErr := enumerators.ToSlice(enumerators.DecodeJSONLines[message](&buffer))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, decodeErr)
+	assert.Equal(t, messages, decoded)
+}
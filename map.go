@@ -9,6 +9,7 @@ type mapEnumerator[T any, U any] struct {
 
 func (e *mapEnumerator[T, U]) MoveNext() bool {
 	if !e.base.MoveNext() {
+		e.err = e.base.Err()
 		return false
 	}
 
@@ -39,6 +40,11 @@ func (e *mapEnumerator[T, U]) Dispose() {
 	e.base.Dispose()
 }
 
+// Len forwards the length of the underlying enumerator, if known.
+func (e *mapEnumerator[T, U]) Len() (int, bool) {
+	return lengthOf(e.base)
+}
+
 // Map creates a mapped enumerator
 func Map[T any, U any](enumerator Enumerator[T], mapper func(T) (U, error)) Enumerator[U] {
 	return &mapEnumerator[T, U]{
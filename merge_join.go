@@ -0,0 +1,181 @@
+package enumerators
+
+import "golang.org/x/exp/constraints"
+
+// joinCursor keeps a one item look-ahead over an enumerator.
+type joinCursor[T any] struct {
+	base Enumerator[T]
+	item T
+	ok   bool
+}
+
+func (c *joinCursor[T]) advance() error {
+	if !c.base.MoveNext() {
+		c.ok = false
+		return c.base.Err()
+	}
+
+	item, err := c.base.Current()
+	if err != nil {
+		c.ok = false
+		return err
+	}
+	c.item = item
+	c.ok = true
+	return nil
+}
+
+type mergeJoinEnumerator[L any, R any, K constraints.Ordered] struct {
+	left       joinCursor[L]
+	right      joinCursor[R]
+	leftKey    func(L) K
+	rightKey   func(R) K
+	kind       JoinKind
+	group      []R
+	groupKey   K
+	groupIndex int
+	activeLeft L
+	emitting   bool
+	current    Joined[L, R]
+	err        error
+	pending    error
+	started    bool
+	done       bool
+}
+
+func (e *mergeJoinEnumerator[L, R, K]) MoveNext() bool {
+	if e.done {
+		return false
+	}
+
+	if !e.started {
+		e.started = true
+		if err := e.left.advance(); err != nil {
+			return e.fail(err)
+		}
+		if err := e.right.advance(); err != nil {
+			return e.fail(err)
+		}
+	}
+
+	for {
+		// Pair the active left row with every buffered right row.
+		if e.emitting {
+			if e.groupIndex < len(e.group) {
+				e.current = Joined[L, R]{
+					Left:     e.activeLeft,
+					Right:    e.group[e.groupIndex],
+					HasLeft:  true,
+					HasRight: true,
+				}
+				e.groupIndex++
+				return true
+			}
+			e.emitting = false
+		}
+
+		// Reuse the buffered right rows for the next left row with the same key.
+		if len(e.group) > 0 {
+			if e.left.ok && e.leftKey(e.left.item) == e.groupKey {
+				e.activeLeft = e.left.item
+				e.groupIndex = 0
+				e.emitting = true
+				if err := e.left.advance(); err != nil {
+					e.postpone(err)
+				}
+				continue
+			}
+			clear(e.group)
+			e.group = e.group[:0]
+		}
+
+		// Rows read before a failed advance have been yielded; any further
+		// decision would depend on the failed side.
+		if e.pending != nil {
+			return e.fail(e.pending)
+		}
+
+		switch {
+		case !e.left.ok && !e.right.ok:
+			e.done = true
+			return false
+
+		case e.right.ok && (!e.left.ok || e.rightKey(e.right.item) < e.leftKey(e.left.item)):
+			item := e.right.item
+			if err := e.right.advance(); err != nil {
+				e.postpone(err)
+			}
+			if e.kind.keepsRight() {
+				e.current = Joined[L, R]{Right: item, HasRight: true}
+				return true
+			}
+
+		case e.left.ok && (!e.right.ok || e.leftKey(e.left.item) < e.rightKey(e.right.item)):
+			item := e.left.item
+			if err := e.left.advance(); err != nil {
+				e.postpone(err)
+			}
+			if e.kind.keepsLeft() {
+				e.current = Joined[L, R]{Left: item, HasLeft: true}
+				return true
+			}
+
+		default:
+			// Buffer every right row sharing the key; left rows stream past it.
+			e.groupKey = e.rightKey(e.right.item)
+			for e.right.ok && e.rightKey(e.right.item) == e.groupKey {
+				e.group = append(e.group, e.right.item)
+				if err := e.right.advance(); err != nil {
+					e.postpone(err)
+				}
+			}
+		}
+	}
+}
+
+// postpone records a failed advance so it is reported once the rows already
+// read have been yielded. The first error wins.
+func (e *mergeJoinEnumerator[L, R, K]) postpone(err error) {
+	if e.pending == nil {
+		e.pending = err
+	}
+}
+
+func (e *mergeJoinEnumerator[L, R, K]) fail(err error) bool {
+	e.err = err
+	e.done = true
+	return false
+}
+
+func (e *mergeJoinEnumerator[L, R, K]) Current() (Joined[L, R], error) {
+	return e.current, e.err
+}
+
+func (e *mergeJoinEnumerator[L, R, K]) Err() error {
+	return e.err
+}
+
+func (e *mergeJoinEnumerator[L, R, K]) Dispose() {
+	e.left.base.Dispose()
+	e.right.base.Dispose()
+	e.group = nil
+}
+
+// MergeJoin joins two enumerators that are both sorted ascending by key.
+// Duplicate keys produce every pairing; only the right rows of the current key
+// are buffered, so memory is bounded by the largest run of equal right keys.
+func MergeJoin[L any, R any, K constraints.Ordered](
+	left Enumerator[L],
+	right Enumerator[R],
+	leftKey func(L) K,
+	rightKey func(R) K,
+	kind JoinKind,
+) Enumerator[Joined[L, R]] {
+	return &mergeJoinEnumerator[L, R, K]{
+		left:     joinCursor[L]{base: left},
+		right:    joinCursor[R]{base: right},
+		leftKey:  leftKey,
+		rightKey: rightKey,
+		kind:     kind,
+	}
+}
@@ -0,0 +1,113 @@
+package enumerators_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+type row struct {
+	Key  int
+	Name string
+}
+
+func joinRows(kind enumerators.JoinKind) ([]string, error) {
+	left := enumerators.Slice([]row{{1, "l1"}, {2, "l2a"}, {2, "l2b"}, {4, "l4"}})
+	right := enumerators.Slice([]row{{2, "r2a"}, {2, "r2b"}, {3, "r3"}, {4, "r4"}})
+	key := func(r row) int { return r.Key }
+
+	joined := enumerators.MergeJoin(left, right, key, key, kind)
+	return enumerators.ToSlice(enumerators.Map(joined, func(j enumerators.Joined[row, row]) (string, error) {
+		l, r := "-", "-"
+		if j.HasLeft {
+			l = j.Left.Name
+		}
+		if j.HasRight {
+			r = j.Right.Name
+		}
+		return l + ":" + r, nil
+	}))
+}
+
+func TestMergeJoin_Inner(t *testing.T) {
+	// Act
+	result, err := joinRows(enumerators.InnerJoin)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"l2a:r2a", "l2a:r2b", "l2b:r2a", "l2b:r2b", "l4:r4"}, result)
+}
+
+func TestMergeJoin_LeftOuter(t *testing.T) {
+	// Act
+	result, err := joinRows(enumerators.LeftOuterJoin)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"l1:-", "l2a:r2a", "l2a:r2b", "l2b:r2a", "l2b:r2b", "l4:r4"}, result)
+}
+
+func TestMergeJoin_RightOuter(t *testing.T) {
+	// Act
+	result, err := joinRows(enumerators.RightOuterJoin)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"l2a:r2a", "l2a:r2b", "l2b:r2a", "l2b:r2b", "-:r3", "l4:r4"}, result)
+}
+
+func TestMergeJoin_FullOuter(t *testing.T) {
+	// Act
+	result, err := joinRows(enumerators.FullOuterJoin)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"l1:-", "l2a:r2a", "l2a:r2b", "l2b:r2a", "l2b:r2b", "-:r3", "l4:r4"}, result)
+}
+
+func TestMergeJoin_BubbleError(t *testing.T) {
+	// Arrange
+	left := enumerators.Slice([]int{1, 2, 3})
+	right := enumerators.Map(enumerators.Slice([]int{1, 2, 3}), func(i int) (int, error) {
+		if i == 3 {
+			return 0, errors.New("bubble error")
+		}
+		return i, nil
+	})
+	key := func(i int) int { return i }
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.MergeJoin(left, right, key, key, enumerators.InnerJoin))
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+	expected := []enumerators.Joined[int, int]{
+		{Left: 1, Right: 1, HasLeft: true, HasRight: true},
+		{Left: 2, Right: 2, HasLeft: true, HasRight: true},
+	}
+	assert.Equal(t, expected, result)
+}
+
+func TestMergeJoin_BubbleErrorBeforeUnmatchedRows(t *testing.T) {
+	// Arrange
+	left := enumerators.Slice([]int{1, 2, 3})
+	right := enumerators.Map(enumerators.Slice([]int{1, 2}), func(i int) (int, error) {
+		if i == 2 {
+			return 0, errors.New("bubble error")
+		}
+		return i, nil
+	})
+	key := func(i int) int { return i }
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.MergeJoin(left, right, key, key, enumerators.LeftOuterJoin))
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+	expected := []enumerators.Joined[int, int]{
+		{Left: 1, Right: 1, HasLeft: true, HasRight: true},
+	}
+	assert.Equal(t, expected, result)
+}
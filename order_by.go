@@ -0,0 +1,267 @@
+package enumerators
+
+import (
+	"bufio"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"slices"
+)
+
+// ItemEncoder writes items to a spill file.
+type ItemEncoder interface {
+	Encode(v any) error
+}
+
+// ItemDecoder reads items back from a spill file. Decode returns io.EOF once
+// the file is exhausted.
+type ItemDecoder interface {
+	Decode(v any) error
+}
+
+// SortCodec serialises sorted runs that OrderByWithOptions spills to disk.
+type SortCodec interface {
+	NewEncoder(w io.Writer) ItemEncoder
+	NewDecoder(r io.Reader) ItemDecoder
+}
+
+// GobCodec spills items with encoding/gob. Only exported fields are kept.
+type GobCodec struct{}
+
+func (GobCodec) NewEncoder(w io.Writer) ItemEncoder {
+	return gob.NewEncoder(w)
+}
+
+func (GobCodec) NewDecoder(r io.Reader) ItemDecoder {
+	return gob.NewDecoder(r)
+}
+
+// JSONCodec spills items with encoding/json.
+type JSONCodec struct{}
+
+func (JSONCodec) NewEncoder(w io.Writer) ItemEncoder {
+	return json.NewEncoder(w)
+}
+
+func (JSONCodec) NewDecoder(r io.Reader) ItemDecoder {
+	return json.NewDecoder(r)
+}
+
+// SortOptions configures OrderByWithOptions.
+type SortOptions struct {
+	// MaxInMemory is the number of items held in memory before a sorted run
+	// is spilled to disk. Zero keeps everything in memory.
+	MaxInMemory int
+	// TempDir is where spill files are created. Empty uses os.TempDir.
+	TempDir string
+	// Codec serialises spilled items. Nil uses GobCodec.
+	Codec SortCodec
+}
+
+type orderByEnumerator[T any] struct {
+	base    Enumerator[T]
+	less    func(a, b T) bool
+	options SortOptions
+	sorted  Enumerator[T]
+	spills  []string
+	err     error
+	started bool
+}
+
+func (e *orderByEnumerator[T]) MoveNext() bool {
+	if !e.started {
+		e.started = true
+		e.sorted, e.err = e.sort()
+		if e.err != nil {
+			e.removeSpills()
+			return false
+		}
+	}
+
+	if e.sorted == nil {
+		return false
+	}
+	if !e.sorted.MoveNext() {
+		e.err = e.sorted.Err()
+		return false
+	}
+	return true
+}
+
+func (e *orderByEnumerator[T]) Current() (T, error) {
+	if e.sorted == nil || e.err != nil {
+		var zero T
+		return zero, e.err
+	}
+	return e.sorted.Current()
+}
+
+func (e *orderByEnumerator[T]) Err() error {
+	return e.err
+}
+
+// Dispose releases the source and removes any spill files.
+func (e *orderByEnumerator[T]) Dispose() {
+	e.base.Dispose()
+	if e.sorted != nil {
+		e.sorted.Dispose()
+		e.sorted = nil
+	}
+}
+
+// sort drains the source, spilling sorted runs once the memory budget is
+// reached, and returns an enumerator over the merged result.
+func (e *orderByEnumerator[T]) sort() (Enumerator[T], error) {
+	var buffer []T
+	for e.base.MoveNext() {
+		item, err := e.base.Current()
+		if err != nil {
+			return nil, err
+		}
+
+		buffer = append(buffer, item)
+		if e.options.MaxInMemory > 0 && len(buffer) >= e.options.MaxInMemory {
+			if err := e.spill(buffer); err != nil {
+				return nil, err
+			}
+			buffer = buffer[:0]
+		}
+	}
+	if err := e.base.Err(); err != nil {
+		return nil, err
+	}
+
+	slices.SortStableFunc(buffer, e.compare)
+	if len(e.spills) == 0 {
+		return Slice(buffer), nil
+	}
+
+	runs := make([]Enumerator[T], 0, len(e.spills)+1)
+	for _, path := range e.spills {
+		runs = append(runs, &spillEnumerator[T]{path: path, codec: e.options.Codec})
+	}
+	e.spills = nil
+	runs = append(runs, Slice(buffer))
+	return InterleaveFunc(runs, e.less), nil
+}
+
+func (e *orderByEnumerator[T]) compare(a, b T) int {
+	if e.less(a, b) {
+		return -1
+	}
+	if e.less(b, a) {
+		return 1
+	}
+	return 0
+}
+
+// spill sorts the buffer and writes it to a new temporary file.
+func (e *orderByEnumerator[T]) spill(buffer []T) (err error) {
+	slices.SortStableFunc(buffer, e.compare)
+
+	file, err := os.CreateTemp(e.options.TempDir, "enumerators-sort-*")
+	if err != nil {
+		return err
+	}
+	e.spills = append(e.spills, file.Name())
+	defer func() {
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	writer := bufio.NewWriter(file)
+	encoder := e.options.Codec.NewEncoder(writer)
+	for _, item := range buffer {
+		if err := encoder.Encode(item); err != nil {
+			return err
+		}
+	}
+	return writer.Flush()
+}
+
+func (e *orderByEnumerator[T]) removeSpills() {
+	for _, path := range e.spills {
+		os.Remove(path)
+	}
+	e.spills = nil
+}
+
+// spillEnumerator reads a sorted run back from disk and removes the file on
+// Dispose.
+type spillEnumerator[T any] struct {
+	path    string
+	codec   SortCodec
+	file    *os.File
+	decoder ItemDecoder
+	current T
+	err     error
+	done    bool
+}
+
+func (e *spillEnumerator[T]) MoveNext() bool {
+	if e.done {
+		return false
+	}
+
+	if e.file == nil {
+		file, err := os.Open(e.path)
+		if err != nil {
+			e.err = err
+			e.done = true
+			return false
+		}
+		e.file = file
+		e.decoder = e.codec.NewDecoder(bufio.NewReader(file))
+	}
+
+	var item T
+	if err := e.decoder.Decode(&item); err != nil {
+		if !errors.Is(err, io.EOF) {
+			e.err = err
+		}
+		e.done = true
+		return false
+	}
+	e.current = item
+	return true
+}
+
+func (e *spillEnumerator[T]) Current() (T, error) {
+	return e.current, e.err
+}
+
+func (e *spillEnumerator[T]) Err() error {
+	return e.err
+}
+
+func (e *spillEnumerator[T]) Dispose() {
+	e.done = true
+	if e.file != nil {
+		e.file.Close()
+		e.file = nil
+	}
+	os.Remove(e.path)
+}
+
+// OrderBy sorts all items in memory. The sort is stable. The source is fully
+// consumed on the first call to MoveNext.
+func OrderBy[T any](enumerator Enumerator[T], less func(a, b T) bool) Enumerator[T] {
+	return OrderByWithOptions(enumerator, less, SortOptions{})
+}
+
+// OrderByWithOptions sorts all items, spilling sorted runs of at most
+// MaxInMemory items to temporary files and k-way merging them with
+// InterleaveFunc. The sort is stable. Spill files are removed on Dispose.
+func OrderByWithOptions[T any](enumerator Enumerator[T], less func(a, b T) bool, options SortOptions) Enumerator[T] {
+	if options.Codec == nil {
+		options.Codec = GobCodec{}
+	}
+	return &orderByEnumerator[T]{
+		base:    enumerator,
+		less:    less,
+		options: options,
+	}
+}
@@ -0,0 +1,93 @@
+package enumerators_test
+
+import (
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+type record struct {
+	Key   int
+	Order int
+}
+
+func byKey(a, b record) bool {
+	return a.Key < b.Key
+}
+
+func unsortedRecords() []record {
+	keys := []int{5, 3, 9, 1, 3, 7, 5, 2, 8, 1}
+	records := make([]record, len(keys))
+	for i, k := range keys {
+		records[i] = record{Key: k, Order: i}
+	}
+	return records
+}
+
+func assertSortedStable(t *testing.T, result []record) {
+	assert.Len(t, result, 10)
+	for i := 1; i < len(result); i++ {
+		prev, next := result[i-1], result[i]
+		assert.True(t, prev.Key < next.Key || prev.Key == next.Key && prev.Order < next.Order, "out of order at %d", i)
+	}
+}
+
+func TestOrderBy_InMemory(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.OrderBy(enumerators.Slice(unsortedRecords()), byKey))
+
+	// Assert
+	assert.NoError(t, err)
+	assertSortedStable(t, result)
+}
+
+func TestOrderByWithOptions_Spills(t *testing.T) {
+	for _, codec := range []enumerators.SortCodec{enumerators.GobCodec{}, enumerators.JSONCodec{}} {
+		// Arrange
+		dir := t.TempDir()
+		sorted := enumerators.OrderByWithOptions(enumerators.Slice(unsortedRecords()), byKey, enumerators.SortOptions{
+			MaxInMemory: 3,
+			TempDir:     dir,
+			Codec:       codec,
+		})
+
+		// Act
+		var result []record
+		for sorted.MoveNext() {
+			item, err := sorted.Current()
+			assert.NoError(t, err)
+			result = append(result, item)
+		}
+		spills, _ := os.ReadDir(dir)
+		sorted.Dispose()
+		remaining, _ := os.ReadDir(dir)
+
+		// Assert
+		assert.NoError(t, sorted.Err())
+		assertSortedStable(t, result)
+		assert.Len(t, spills, 3)
+		assert.Empty(t, remaining)
+	}
+}
+
+func TestOrderByWithOptions_BubbleErrorRemovesSpills(t *testing.T) {
+	// Arrange
+	dir := t.TempDir()
+	source := enumerators.Map(enumerators.Slice(unsortedRecords()), func(r record) (record, error) {
+		if r.Order == 8 {
+			return r, errors.New("bubble error")
+		}
+		return r, nil
+	})
+
+	// Act
+	_, err := enumerators.ToSlice(enumerators.OrderByWithOptions(source, byKey, enumerators.SortOptions{MaxInMemory: 3, TempDir: dir}))
+	remaining, _ := os.ReadDir(dir)
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+	assert.Empty(t, remaining)
+}
@@ -0,0 +1,7 @@
+package enumerators
+
+// Pair holds two related values.
+type Pair[T any, U any] struct {
+	First  T
+	Second U
+}
@@ -0,0 +1,205 @@
+package enumerators
+
+import (
+	"context"
+	"sync"
+)
+
+type parallelResult[U any] struct {
+	item U
+	err  error
+}
+
+type parallelJob[T any, U any] struct {
+	item T
+	slot chan parallelResult[U]
+}
+
+type parallelMapEnumerator[T any, U any] struct {
+	base    Enumerator[T]
+	mapper  func(context.Context, T) (U, error)
+	parent  context.Context
+	workers int
+	pending chan chan parallelResult[U]
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	start   sync.Once
+	dispose sync.Once
+	current U
+	err     error
+	done    bool
+}
+
+// MoveNext waits for the next result in source order.
+func (e *parallelMapEnumerator[T, U]) MoveNext() bool {
+	if e.done {
+		return false
+	}
+	e.start.Do(e.run)
+
+	var slot chan parallelResult[U]
+	var ok bool
+	select {
+	case slot, ok = <-e.pending:
+		if !ok {
+			e.done = true
+			return false
+		}
+	case <-e.ctx.Done():
+		return e.cancelled()
+	}
+
+	var result parallelResult[U]
+	select {
+	case result = <-slot:
+	case <-e.ctx.Done():
+		return e.cancelled()
+	}
+
+	if result.err != nil {
+		e.err = result.err
+		e.done = true
+		e.cancel()
+		return false
+	}
+
+	e.current = result.item
+	return true
+}
+
+// cancelled ends the enumeration, reporting the caller's context error if that
+// is what stopped the workers.
+func (e *parallelMapEnumerator[T, U]) cancelled() bool {
+	e.done = true
+	e.err = e.parent.Err()
+	return false
+}
+
+func (e *parallelMapEnumerator[T, U]) Current() (U, error) {
+	return e.current, e.err
+}
+
+func (e *parallelMapEnumerator[T, U]) Err() error {
+	return e.err
+}
+
+// Dispose cancels outstanding work, waits for every worker to exit and then
+// disposes the source.
+func (e *parallelMapEnumerator[T, U]) Dispose() {
+	e.dispose.Do(func() {
+		e.done = true
+		e.start.Do(func() {})
+		if e.cancel != nil {
+			e.cancel()
+			e.wg.Wait()
+		}
+		e.base.Dispose()
+	})
+}
+
+func (e *parallelMapEnumerator[T, U]) run() {
+	e.ctx, e.cancel = context.WithCancel(e.parent)
+	e.pending = make(chan chan parallelResult[U], e.workers)
+	jobs := make(chan parallelJob[T, U], e.workers)
+
+	e.wg.Add(e.workers + 1)
+	for i := 0; i < e.workers; i++ {
+		go e.work(jobs)
+	}
+	go e.dispatch(jobs)
+}
+
+// dispatch is the only goroutine that touches the source enumerator.
+func (e *parallelMapEnumerator[T, U]) dispatch(jobs chan<- parallelJob[T, U]) {
+	defer e.wg.Done()
+	defer close(jobs)
+	defer close(e.pending)
+
+	fail := func(err error) {
+		slot := make(chan parallelResult[U], 1)
+		slot <- parallelResult[U]{err: err}
+		select {
+		case e.pending <- slot:
+		case <-e.ctx.Done():
+		}
+	}
+
+	for e.ctx.Err() == nil && e.base.MoveNext() {
+		item, err := e.base.Current()
+		if err != nil {
+			fail(err)
+			return
+		}
+
+		slot := make(chan parallelResult[U], 1)
+		select {
+		case e.pending <- slot:
+		case <-e.ctx.Done():
+			return
+		}
+
+		// select picks at random when both cases are ready, so check again
+		// before handing out work after cancellation.
+		if e.ctx.Err() != nil {
+			return
+		}
+		select {
+		case jobs <- parallelJob[T, U]{item: item, slot: slot}:
+		case <-e.ctx.Done():
+			return
+		}
+	}
+
+	if err := e.base.Err(); err != nil {
+		fail(err)
+	}
+}
+
+func (e *parallelMapEnumerator[T, U]) work(jobs <-chan parallelJob[T, U]) {
+	defer e.wg.Done()
+	for {
+		select {
+		case job, ok := <-jobs:
+			// A job may still be received after cancellation because select
+			// picks at random; don't start the mapper for it.
+			if !ok || e.ctx.Err() != nil {
+				return
+			}
+			u, err := e.mapper(e.ctx, job.item)
+			job.slot <- parallelResult[U]{item: u, err: err}
+		case <-e.ctx.Done():
+			return
+		}
+	}
+}
+
+// ParallelMap creates a mapped enumerator that runs the mapper on up to
+// workers goroutines while still yielding results in source order. At most
+// workers items are in flight ahead of the consumer. The first error, in
+// source order, stops the enumeration and is reported by Err.
+//
+// The source is pulled from a single background goroutine. Dispose stops
+// handing out work and waits for that goroutine and for mapper calls already
+// in flight, so a source that blocks in MoveNext or a slow mapper delays
+// Dispose until it returns. Use ParallelMapCtx to cancel in-flight calls.
+func ParallelMap[T any, U any](enumerator Enumerator[T], workers int, mapper func(T) (U, error)) Enumerator[U] {
+	return ParallelMapCtx(context.Background(), enumerator, workers, func(ctx context.Context, item T) (U, error) {
+		return mapper(item)
+	})
+}
+
+// ParallelMapCtx is ParallelMap with a context-aware mapper. The context
+// passed to the mapper is cancelled on Dispose, on the first error and when
+// ctx is done, in which case Err reports ctx.Err().
+func ParallelMapCtx[T any, U any](ctx context.Context, enumerator Enumerator[T], workers int, mapper func(context.Context, T) (U, error)) Enumerator[U] {
+	if workers < 1 {
+		workers = 1
+	}
+	return &parallelMapEnumerator[T, U]{
+		base:    enumerator,
+		mapper:  mapper,
+		parent:  ctx,
+		workers: workers,
+	}
+}
@@ -0,0 +1,156 @@
+package enumerators_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelMap_PreservesOrder(t *testing.T) {
+	// Arrange
+	source := enumerators.Range(0, 50, func(i int) int { return i })
+	mapped := enumerators.ParallelMap(source, 4, func(i int) (int, error) {
+		time.Sleep(time.Duration(50-i) * 50 * time.Microsecond)
+		return i * 2, nil
+	})
+
+	// Act
+	result, err := enumerators.ToSlice(mapped)
+
+	// Assert
+	assert.NoError(t, err)
+	expected := make([]int, 50)
+	for i := range expected {
+		expected[i] = i * 2
+	}
+	assert.Equal(t, expected, result)
+}
+
+func TestParallelMap_BoundsConcurrency(t *testing.T) {
+	// Arrange
+	var active, peak atomic.Int32
+	source := enumerators.Range(0, 40, func(i int) int { return i })
+	mapped := enumerators.ParallelMap(source, 3, func(i int) (int, error) {
+		n := active.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(time.Millisecond)
+		active.Add(-1)
+		return i, nil
+	})
+
+	// Act
+	result, err := enumerators.ToSlice(mapped)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result, 40)
+	assert.LessOrEqual(t, peak.Load(), int32(3))
+}
+
+func TestParallelMap_PropagatesError(t *testing.T) {
+	// Arrange
+	source := enumerators.Range(0, 20, func(i int) int { return i })
+	mapped := enumerators.ParallelMap(source, 4, func(i int) (int, error) {
+		if i == 5 {
+			return 0, errors.New("boom")
+		}
+		return i, nil
+	})
+
+	// Act
+	result, err := enumerators.ToSlice(mapped)
+
+	// Assert
+	assert.EqualError(t, err, "boom")
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, result)
+}
+
+func TestParallelMap_DisposeStopsWorkers(t *testing.T) {
+	// Arrange
+	var calls atomic.Int32
+	disposed := false
+	source := enumerators.GenerateAndDispose(func() (int, bool, error) {
+		return 1, true, nil
+	}, func() { disposed = true })
+	mapped := enumerators.ParallelMap(source, 2, func(i int) (int, error) {
+		calls.Add(1)
+		return i, nil
+	})
+
+	// Act
+	assert.True(t, mapped.MoveNext())
+	mapped.Dispose()
+	after := calls.Load()
+	time.Sleep(5 * time.Millisecond)
+
+	// Assert
+	assert.True(t, disposed)
+	assert.Equal(t, after, calls.Load())
+	assert.False(t, mapped.MoveNext())
+}
+
+func TestParallelMapCtx_NoMapperCallsAfterCancel(t *testing.T) {
+	for trial := 0; trial < 20; trial++ {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		release := make(chan struct{})
+		var late atomic.Int32
+		source := enumerators.Range(0, 100, func(i int) int { return i })
+		mapped := enumerators.ParallelMapCtx(ctx, source, 4, func(ctx context.Context, i int) (int, error) {
+			if ctx.Err() != nil {
+				late.Add(1)
+			}
+			// Ignore ctx, like a mapper doing uncancellable I/O.
+			<-release
+			return i, nil
+		})
+		go func() {
+			time.Sleep(5 * time.Millisecond)
+			cancel()
+			time.Sleep(5 * time.Millisecond)
+			close(release)
+		}()
+
+		// Act
+		hasNext := mapped.MoveNext()
+		mapped.Dispose()
+
+		// Assert
+		assert.False(t, hasNext)
+		assert.ErrorIs(t, mapped.Err(), context.Canceled)
+		assert.Zero(t, late.Load(), "mapper started after cancellation")
+	}
+}
+
+func TestParallelMapCtx_CancelsInFlightCalls(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	source := enumerators.Range(0, 100, func(i int) int { return i })
+	mapped := enumerators.ParallelMapCtx(ctx, source, 2, func(ctx context.Context, i int) (int, error) {
+		if i == 0 {
+			return i, nil
+		}
+		<-ctx.Done()
+		return 0, ctx.Err()
+	})
+
+	// Act
+	assert.True(t, mapped.MoveNext())
+	cancel()
+	hasNext := mapped.MoveNext()
+	mapped.Dispose()
+
+	// Assert
+	assert.False(t, hasNext)
+	assert.ErrorIs(t, mapped.Err(), context.Canceled)
+}
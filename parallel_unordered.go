@@ -0,0 +1,184 @@
+package enumerators
+
+import (
+	"context"
+	"sync"
+)
+
+type parallelUnorderedEnumerator[T any, U any] struct {
+	base    Enumerator[T]
+	workers int
+	process func(item T, publish func(U) bool) error
+	channel *ChannelEnumerator[U]
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	start   sync.Once
+	dispose sync.Once
+	mu      sync.Mutex
+	err     error
+	done    bool
+}
+
+func (e *parallelUnorderedEnumerator[T, U]) MoveNext() bool {
+	if e.done {
+		return false
+	}
+	e.start.Do(e.run)
+	return e.channel.MoveNext()
+}
+
+func (e *parallelUnorderedEnumerator[T, U]) Current() (U, error) {
+	if e.channel == nil {
+		var zero U
+		return zero, e.Err()
+	}
+	current, _ := e.channel.Current()
+	return current, e.Err()
+}
+
+func (e *parallelUnorderedEnumerator[T, U]) Err() error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.err
+}
+
+// Dispose cancels outstanding work, waits for every worker to exit and then
+// disposes the source.
+func (e *parallelUnorderedEnumerator[T, U]) Dispose() {
+	e.dispose.Do(func() {
+		e.done = true
+		e.start.Do(func() {})
+		if e.channel != nil {
+			e.cancel()
+			e.wg.Wait()
+			e.channel.Dispose()
+		}
+		e.base.Dispose()
+	})
+}
+
+// fail records the first error and stops all workers.
+func (e *parallelUnorderedEnumerator[T, U]) fail(err error) {
+	e.mu.Lock()
+	if e.err == nil {
+		e.err = err
+	}
+	e.mu.Unlock()
+	e.cancel()
+}
+
+func (e *parallelUnorderedEnumerator[T, U]) run() {
+	var ctx context.Context
+	ctx, e.cancel = context.WithCancel(context.Background())
+	e.channel = Channel[U](ctx, e.workers)
+	jobs := make(chan T, e.workers)
+
+	e.wg.Add(e.workers + 1)
+	for i := 0; i < e.workers; i++ {
+		go e.work(ctx, jobs)
+	}
+	go e.dispatch(ctx, jobs)
+
+	go func() {
+		e.wg.Wait()
+		e.channel.Complete()
+	}()
+}
+
+// dispatch is the only goroutine that touches the source enumerator.
+func (e *parallelUnorderedEnumerator[T, U]) dispatch(ctx context.Context, jobs chan<- T) {
+	defer e.wg.Done()
+	defer close(jobs)
+
+	for ctx.Err() == nil && e.base.MoveNext() {
+		item, err := e.base.Current()
+		if err != nil {
+			e.fail(err)
+			return
+		}
+
+		// select picks at random when both cases are ready, so check again
+		// before handing out work after cancellation.
+		if ctx.Err() != nil {
+			return
+		}
+		select {
+		case jobs <- item:
+		case <-ctx.Done():
+			return
+		}
+	}
+
+	if err := e.base.Err(); err != nil {
+		e.fail(err)
+	}
+}
+
+func (e *parallelUnorderedEnumerator[T, U]) work(ctx context.Context, jobs <-chan T) {
+	defer e.wg.Done()
+	for {
+		select {
+		case item, ok := <-jobs:
+			// A job may still be received after cancellation because select
+			// picks at random; don't start processing it.
+			if !ok || ctx.Err() != nil {
+				return
+			}
+			if err := e.process(item, e.channel.Publish); err != nil {
+				e.fail(err)
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// ParallelMapUnordered creates a mapped enumerator that runs the mapper on up
+// to workers goroutines and yields each result as soon as it is ready. The
+// first error stops all workers and is reported by Err.
+func ParallelMapUnordered[T any, U any](enumerator Enumerator[T], workers int, mapper func(T) (U, error)) Enumerator[U] {
+	if workers < 1 {
+		workers = 1
+	}
+	return &parallelUnorderedEnumerator[T, U]{
+		base:    enumerator,
+		workers: workers,
+		process: func(item T, publish func(U) bool) error {
+			u, err := mapper(item)
+			if err != nil {
+				return err
+			}
+			publish(u)
+			return nil
+		},
+	}
+}
+
+// ParallelFlatMap creates a flat-mapped enumerator that drains up to workers
+// inner enumerators concurrently and yields their items as soon as they are
+// ready. Every inner enumerator is disposed, even when it fails or the
+// consumer disposes early.
+func ParallelFlatMap[T any, U any](enumerator Enumerator[T], workers int, mapper func(T) Enumerator[U]) Enumerator[U] {
+	if workers < 1 {
+		workers = 1
+	}
+	return &parallelUnorderedEnumerator[T, U]{
+		base:    enumerator,
+		workers: workers,
+		process: func(item T, publish func(U) bool) error {
+			inner := mapper(item)
+			defer inner.Dispose()
+			for inner.MoveNext() {
+				u, err := inner.Current()
+				if err != nil {
+					return err
+				}
+				if !publish(u) {
+					return nil
+				}
+			}
+			return inner.Err()
+		},
+	}
+}
@@ -0,0 +1,140 @@
+package enumerators_test
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParallelMapUnordered(t *testing.T) {
+	// Arrange
+	source := enumerators.Range(0, 100, func(i int) int { return i })
+	mapped := enumerators.ParallelMapUnordered(source, 8, func(i int) (int, error) {
+		return i * 2, nil
+	})
+
+	// Act
+	result, err := enumerators.ToSlice(mapped)
+
+	// Assert
+	assert.NoError(t, err)
+	expected := make([]int, 100)
+	for i := range expected {
+		expected[i] = i * 2
+	}
+	assert.ElementsMatch(t, expected, result)
+}
+
+func TestParallelMapUnordered_PropagatesError(t *testing.T) {
+	// Arrange
+	source := enumerators.Range(0, 100, func(i int) int { return i })
+	mapped := enumerators.ParallelMapUnordered(source, 4, func(i int) (int, error) {
+		if i == 10 {
+			return 0, errors.New("boom")
+		}
+		return i, nil
+	})
+
+	// Act
+	_, err := enumerators.ToSlice(mapped)
+
+	// Assert
+	assert.EqualError(t, err, "boom")
+}
+
+func TestParallelMapUnordered_DisposeEarly(t *testing.T) {
+	// Arrange
+	disposed := false
+	source := enumerators.GenerateAndDispose(func() (int, bool, error) {
+		return 1, true, nil
+	}, func() { disposed = true })
+	mapped := enumerators.ParallelMapUnordered(source, 4, func(i int) (int, error) {
+		return i, nil
+	})
+
+	// Act
+	assert.True(t, mapped.MoveNext())
+	mapped.Dispose()
+
+	// Assert
+	assert.True(t, disposed)
+	assert.False(t, mapped.MoveNext())
+}
+
+func TestParallelMapUnordered_NoMapperCallsAfterDispose(t *testing.T) {
+	for trial := 0; trial < 20; trial++ {
+		// Arrange
+		release := make(chan struct{})
+		var disposing atomic.Bool
+		var late atomic.Int32
+		source := enumerators.Range(0, 100, func(i int) int { return i })
+		mapped := enumerators.ParallelMapUnordered(source, 4, func(i int) (int, error) {
+			if disposing.Load() {
+				late.Add(1)
+			}
+			if i > 0 {
+				<-release
+			}
+			return i, nil
+		})
+
+		// Act
+		assert.True(t, mapped.MoveNext())
+		time.Sleep(5 * time.Millisecond)
+		disposing.Store(true)
+		go func() {
+			time.Sleep(5 * time.Millisecond)
+			close(release)
+		}()
+		mapped.Dispose()
+
+		// Assert
+		assert.Zero(t, late.Load(), "mapper started after Dispose")
+	}
+}
+
+func TestParallelFlatMap(t *testing.T) {
+	// Arrange
+	var disposed atomic.Int32
+	source := enumerators.Slice([]int{1, 2, 3})
+	flattened := enumerators.ParallelFlatMap(source, 2, func(i int) enumerators.Enumerator[int] {
+		inner := enumerators.Slice([]int{i * 10, i*10 + 1})
+		return enumerators.Cleanup(inner, func() { disposed.Add(1) })
+	})
+
+	// Act
+	result, err := enumerators.ToSlice(flattened)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int{10, 11, 20, 21, 30, 31}, result)
+	assert.Equal(t, int32(3), disposed.Load())
+}
+
+func TestParallelFlatMap_DisposesInnerOnError(t *testing.T) {
+	// Arrange
+	var created, disposed atomic.Int32
+	source := enumerators.Slice([]int{1, 2, 3, 4})
+	flattened := enumerators.ParallelFlatMap(source, 2, func(i int) enumerators.Enumerator[int] {
+		created.Add(1)
+		var inner enumerators.Enumerator[int]
+		if i == 2 {
+			inner = enumerators.Error[int](errors.New("boom"))
+		} else {
+			inner = enumerators.Slice([]int{i})
+		}
+		return enumerators.Cleanup(inner, func() { disposed.Add(1) })
+	})
+
+	// Act
+	_, err := enumerators.ToSlice(flattened)
+
+	// Assert
+	assert.EqualError(t, err, "boom")
+	assert.GreaterOrEqual(t, disposed.Load(), int32(1))
+	assert.Equal(t, created.Load(), disposed.Load())
+}
@@ -32,6 +32,14 @@ func (e *rangeEnumerator[T]) Dispose() {
 	// no-op
 }
 
+// Len returns the number of values not yet enumerated.
+func (e *rangeEnumerator[T]) Len() (int, bool) {
+	if e.start >= e.end {
+		return 0, true
+	}
+	return e.end - e.start, true
+}
+
 func Range[T any](seed int, count int, factory func(i int) T) Enumerator[T] {
 	return &rangeEnumerator[T]{
 		start:   seed,
@@ -0,0 +1,141 @@
+package enumerators
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+// DefaultMaxTokenSize is the largest token the reader sources accept unless
+// configured otherwise.
+const DefaultMaxTokenSize = bufio.MaxScanTokenSize
+
+// ReaderOptions configures the io.Reader sources.
+type ReaderOptions struct {
+	// MaxTokenSize is the largest line or token that can be read. Longer
+	// tokens fail with bufio.ErrTooLong. Zero uses DefaultMaxTokenSize.
+	MaxTokenSize int
+}
+
+type scannerEnumerator[T any] struct {
+	reader   io.Reader
+	scanner  *bufio.Scanner
+	convert  func([]byte) T
+	current  T
+	err      error
+	disposed bool
+}
+
+func (e *scannerEnumerator[T]) MoveNext() bool {
+	if e.disposed {
+		return false
+	}
+
+	if !e.scanner.Scan() {
+		e.err = e.scanner.Err()
+		return false
+	}
+	e.current = e.convert(e.scanner.Bytes())
+	return true
+}
+
+func (e *scannerEnumerator[T]) Current() (T, error) {
+	return e.current, e.err
+}
+
+func (e *scannerEnumerator[T]) Err() error {
+	return e.err
+}
+
+// Dispose closes the reader if it implements io.Closer.
+func (e *scannerEnumerator[T]) Dispose() {
+	if e.disposed {
+		return
+	}
+	e.disposed = true
+	if closer, ok := e.reader.(io.Closer); ok {
+		closer.Close()
+	}
+}
+
+func newScanner[T any](r io.Reader, split bufio.SplitFunc, options ReaderOptions, convert func([]byte) T) Enumerator[T] {
+	return &scannerEnumerator[T]{
+		reader:  r,
+		scanner: newLineScanner(r, split, options),
+		convert: convert,
+	}
+}
+
+// newLineScanner creates a scanner that honours options.MaxTokenSize.
+func newLineScanner(r io.Reader, split bufio.SplitFunc, options ReaderOptions) *bufio.Scanner {
+	maxTokenSize := options.MaxTokenSize
+	if maxTokenSize <= 0 {
+		maxTokenSize = DefaultMaxTokenSize
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, min(maxTokenSize, 4096)), maxTokenSize)
+	scanner.Split(split)
+	return scanner
+}
+
+func toString(token []byte) string {
+	return string(token)
+}
+
+func toBytes(token []byte) []byte {
+	return bytes.Clone(token)
+}
+
+// Lines yields each line of the reader without its line ending. Scanner
+// errors, including bufio.ErrTooLong, are reported by Err. The reader is
+// closed on Dispose when it implements io.Closer.
+func Lines(r io.Reader) Enumerator[string] {
+	return LinesWithOptions(r, ReaderOptions{})
+}
+
+// LinesWithOptions is Lines with a configurable maximum line length.
+func LinesWithOptions(r io.Reader, options ReaderOptions) Enumerator[string] {
+	return newScanner(r, bufio.ScanLines, options, toString)
+}
+
+// ScanReader yields each token produced by the split function. Tokens are
+// copied so they remain valid after the next call to MoveNext. The reader is
+// closed on Dispose when it implements io.Closer.
+func ScanReader(r io.Reader, split bufio.SplitFunc) Enumerator[[]byte] {
+	return ScanReaderWithOptions(r, split, ReaderOptions{})
+}
+
+// ScanReaderWithOptions is ScanReader with a configurable maximum token size.
+func ScanReaderWithOptions(r io.Reader, split bufio.SplitFunc, options ReaderOptions) Enumerator[[]byte] {
+	return newScanner(r, split, options, toBytes)
+}
+
+// ReadDelimited yields the chunks of the reader separated by delim, without
+// the delimiter. A trailing chunk without a delimiter is yielded as well.
+// The reader is closed on Dispose when it implements io.Closer.
+func ReadDelimited(r io.Reader, delim byte) Enumerator[[]byte] {
+	return ReadDelimitedWithOptions(r, delim, ReaderOptions{})
+}
+
+// ReadDelimitedWithOptions is ReadDelimited with a configurable maximum chunk
+// size.
+func ReadDelimitedWithOptions(r io.Reader, delim byte, options ReaderOptions) Enumerator[[]byte] {
+	return newScanner(r, splitOn(delim), options, toBytes)
+}
+
+// splitOn returns a split function that breaks input at every delim.
+func splitOn(delim byte) bufio.SplitFunc {
+	return func(data []byte, atEOF bool) (int, []byte, error) {
+		if atEOF && len(data) == 0 {
+			return 0, nil, nil
+		}
+		if i := bytes.IndexByte(data, delim); i >= 0 {
+			return i + 1, data[:i], nil
+		}
+		if atEOF {
+			return len(data), data, nil
+		}
+		return 0, nil, nil
+	}
+}
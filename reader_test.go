@@ -0,0 +1,78 @@
+package enumerators_test
+
+import (
+	"bufio"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+type closingReader struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closingReader) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestLines(t *testing.T) {
+	// Arrange
+	reader := &closingReader{Reader: strings.NewReader("one\r\ntwo\nthree")}
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Lines(reader))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"one", "two", "three"}, result)
+	assert.True(t, reader.closed)
+}
+
+func TestLines_TooLong(t *testing.T) {
+	// Arrange
+	reader := strings.NewReader("short\n" + strings.Repeat("x", 64) + "\n")
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.LinesWithOptions(reader, enumerators.ReaderOptions{MaxTokenSize: 16}))
+
+	// Assert
+	assert.ErrorIs(t, err, bufio.ErrTooLong)
+	assert.Equal(t, []string{"short"}, result)
+}
+
+func TestScanReader_Words(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.ScanReader(strings.NewReader("alpha  beta\ngamma"), bufio.ScanWords))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("alpha"), []byte("beta"), []byte("gamma")}, result)
+}
+
+func TestReadDelimited(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.ReadDelimited(strings.NewReader("a,bb,,ccc"), ','))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("bb"), {}, []byte("ccc")}, result)
+}
+
+func TestLines_DisposeEarlyClosesReader(t *testing.T) {
+	// Arrange
+	reader := &closingReader{Reader: strings.NewReader("one\ntwo\n")}
+	lines := enumerators.Lines(reader)
+
+	// Act
+	first, err := enumerators.First(lines)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, "one", first)
+	assert.True(t, reader.closed)
+}
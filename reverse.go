@@ -0,0 +1,73 @@
+package enumerators
+
+type reverseSliceEnumerator[T any] struct {
+	slice   []T
+	cursor  int
+	current T
+}
+
+func (e *reverseSliceEnumerator[T]) MoveNext() bool {
+	if e.cursor <= 0 {
+		return false
+	}
+	e.cursor--
+	e.current = e.slice[e.cursor]
+	return true
+}
+
+func (e *reverseSliceEnumerator[T]) Current() (T, error) {
+	return e.current, nil
+}
+
+func (e *reverseSliceEnumerator[T]) Err() error {
+	return nil
+}
+
+func (e *reverseSliceEnumerator[T]) Dispose() {
+	// no-op
+}
+
+// Len returns the number of items not yet enumerated.
+func (e *reverseSliceEnumerator[T]) Len() (int, bool) {
+	return e.cursor, true
+}
+
+// Reverse yields the items in reverse order. Slice- and range-backed
+// enumerators are walked backwards without copying; anything else is
+// buffered in full on the first call to MoveNext.
+func Reverse[T any](enumerator Enumerator[T]) Enumerator[T] {
+	switch e := enumerator.(type) {
+	case *SliceEnumerator[T]:
+		remaining := e.remaining()
+		return &reverseSliceEnumerator[T]{slice: remaining, cursor: len(remaining)}
+	case *rangeEnumerator[T]:
+		n, _ := e.Len()
+		last := e.start + n - 1
+		return Range(0, n, func(i int) T { return e.factory(last - i) })
+	}
+
+	return &deferredEnumerator[T]{
+		base: enumerator,
+		load: func() ([]T, error) {
+			var items []T
+			if n, ok := lengthOf(enumerator); ok {
+				items = make([]T, 0, n)
+			}
+			for enumerator.MoveNext() {
+				item, err := enumerator.Current()
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, item)
+			}
+
+			if err := enumerator.Err(); err != nil {
+				return nil, err
+			}
+			for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+				items[i], items[j] = items[j], items[i]
+			}
+			return items, nil
+		},
+	}
+}
@@ -0,0 +1,133 @@
+package enumerators_test
+
+import (
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReverse_Slice(t *testing.T) {
+	// Arrange
+	source := enumerators.Slice([]int{1, 2, 3, 4})
+	source.MoveNext()
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Reverse(source))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{4, 3, 2}, result)
+}
+
+func TestReverse_Range(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Reverse(enumerators.Range(5, 3, func(i int) int { return i })))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{7, 6, 5}, result)
+}
+
+func TestReverse_Buffered(t *testing.T) {
+	// Arrange
+	source := enumerators.Filter(enumerators.Slice([]int{1, 2, 3, 4}), func(i int) bool { return i%2 == 0 })
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Reverse(source))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{4, 2}, result)
+}
+
+// disposeCounter counts Dispose calls on the enumerator it wraps.
+type disposeCounter[T any] struct {
+	enumerators.Enumerator[T]
+	disposals int
+}
+
+func (e *disposeCounter[T]) Dispose() {
+	e.disposals++
+	e.Enumerator.Dispose()
+}
+
+func TestReverse_BufferedDisposesSourceOnce(t *testing.T) {
+	// Arrange
+	i := 0
+	source := &disposeCounter[int]{Enumerator: enumerators.Generate(func() (int, bool, error) {
+		i++
+		return i, i <= 3, nil
+	})}
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Reverse[int](source))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 2, 1}, result)
+	assert.Equal(t, 1, source.disposals)
+}
+
+func TestSized_MapForwardsLen(t *testing.T) {
+	// Arrange
+	mapped := enumerators.Map(enumerators.Range(0, 10, func(i int) int { return i }), func(i int) (int, error) { return i, nil })
+
+	// Act
+	n, ok := mapped.(enumerators.Sized).Len()
+
+	// Assert
+	assert.True(t, ok)
+	assert.Equal(t, 10, n)
+}
+
+func TestFastPaths_Range(t *testing.T) {
+	// Arrange
+	calls := 0
+	source := func() enumerators.Enumerator[int] {
+		return enumerators.Range(0, 1000, func(i int) int {
+			calls++
+			return i * 2
+		})
+	}
+
+	// Act
+	count, countErr := enumerators.Count(source())
+	at, atErr := enumerators.ElementAt(source(), 500)
+	last, lastErr := enumerators.Last(source())
+	skipped, skipErr := enumerators.ToSlice(enumerators.Skip(source(), 998))
+
+	// Assert
+	assert.NoError(t, countErr)
+	assert.NoError(t, atErr)
+	assert.NoError(t, lastErr)
+	assert.NoError(t, skipErr)
+	assert.Equal(t, 1000, count)
+	assert.Equal(t, 1000, at)
+	assert.Equal(t, 1998, last)
+	assert.Equal(t, []int{1996, 1998}, skipped)
+	assert.Equal(t, 4, calls)
+}
+
+func TestFastPaths_PartiallyEnumeratedSlice(t *testing.T) {
+	// Arrange
+	source := enumerators.Slice([]int{1, 2, 3, 4})
+	source.MoveNext()
+
+	// Act
+	count, err := enumerators.Count(source)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 3, count)
+}
+
+func TestToSlice_EmptySliceStaysNonNil(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Slice([]int{}))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Empty(t, result)
+}
@@ -0,0 +1,15 @@
+package enumerators
+
+// Scan yields the running accumulator after each item, starting from seed.
+// It is the lazy counterpart of Fold.
+func Scan[T any, U any](enumerator Enumerator[T], seed U, accumulate func(acc U, item T) (U, error)) Enumerator[U] {
+	acc := seed
+	return Map(enumerator, func(item T) (U, error) {
+		next, err := accumulate(acc, item)
+		if err != nil {
+			return next, err
+		}
+		acc = next
+		return acc, nil
+	})
+}
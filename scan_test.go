@@ -0,0 +1,51 @@
+package enumerators_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScan_RunningTotal(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Scan(enumerators.Slice([]int{1, 2, 3, 4}), 0, func(acc, i int) (int, error) {
+		return acc + i, nil
+	}))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 3, 6, 10}, result)
+}
+
+func TestScan_Deltas(t *testing.T) {
+	// Arrange
+	type delta struct {
+		last  int
+		delta int
+	}
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Scan(enumerators.Slice([]int{10, 13, 11}), delta{last: 10}, func(acc delta, i int) (delta, error) {
+		return delta{last: i, delta: i - acc.last}, nil
+	}))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []delta{{10, 0}, {13, 3}, {11, -2}}, result)
+}
+
+func TestScan_BubbleError(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Scan(enumerators.Slice([]int{1, 2, 3}), 0, func(acc, i int) (int, error) {
+		if i == 3 {
+			return 0, errors.New("bubble error")
+		}
+		return acc + i, nil
+	}))
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+	assert.Equal(t, []int{1, 3}, result)
+}
@@ -0,0 +1,219 @@
+package enumerators
+
+import "golang.org/x/exp/constraints"
+
+// Union yields the distinct items of both enumerators in first-seen order.
+func Union[T comparable](first Enumerator[T], second Enumerator[T]) Enumerator[T] {
+	return Distinct(Chain(first, second))
+}
+
+// Intersect yields the distinct items of first that also appear in second.
+// The second enumerator is read into a set on the first call to MoveNext.
+func Intersect[T comparable](first Enumerator[T], second Enumerator[T]) Enumerator[T] {
+	return setFilter(first, second, true)
+}
+
+// Except yields the distinct items of first that do not appear in second.
+// The second enumerator is read into a set on the first call to MoveNext.
+func Except[T comparable](first Enumerator[T], second Enumerator[T]) Enumerator[T] {
+	return setFilter(first, second, false)
+}
+
+func setFilter[T comparable](first Enumerator[T], second Enumerator[T], keep bool) Enumerator[T] {
+	return &setFilterEnumerator[T]{
+		base:   Distinct(first),
+		second: second,
+		keep:   keep,
+	}
+}
+
+// setFilterEnumerator reads second into a set on the first call to MoveNext,
+// so its errors are reported even when first is empty.
+type setFilterEnumerator[T comparable] struct {
+	base    Enumerator[T]
+	second  Enumerator[T]
+	keep    bool
+	set     map[T]struct{}
+	current T
+	err     error
+	loaded  bool
+}
+
+func (e *setFilterEnumerator[T]) MoveNext() bool {
+	if !e.loaded {
+		e.loaded = true
+		if e.err = e.load(); e.err != nil {
+			return false
+		}
+	}
+	if e.err != nil {
+		return false
+	}
+
+	for e.base.MoveNext() {
+		item, err := e.base.Current()
+		if err != nil {
+			e.err = err
+			return false
+		}
+		if _, ok := e.set[item]; ok == e.keep {
+			e.current = item
+			return true
+		}
+	}
+	e.err = e.base.Err()
+	return false
+}
+
+func (e *setFilterEnumerator[T]) load() error {
+	defer e.second.Dispose()
+	e.set = make(map[T]struct{})
+	for e.second.MoveNext() {
+		item, err := e.second.Current()
+		if err != nil {
+			return err
+		}
+		e.set[item] = struct{}{}
+	}
+	return e.second.Err()
+}
+
+func (e *setFilterEnumerator[T]) Current() (T, error) {
+	return e.current, e.err
+}
+
+func (e *setFilterEnumerator[T]) Err() error {
+	return e.err
+}
+
+func (e *setFilterEnumerator[T]) Dispose() {
+	e.base.Dispose()
+	if !e.loaded {
+		e.second.Dispose()
+	}
+	e.set = nil
+}
+
+// SortedUnion merges two enumerators sorted ascending by key and yields one
+// item per distinct key in constant memory. Ties favour the first enumerator.
+func SortedUnion[T any, TOrdered constraints.Ordered](
+	first Enumerator[T],
+	second Enumerator[T],
+	key func(T) TOrdered,
+) Enumerator[T] {
+	return DistinctUntilChanged(Interleave([]Enumerator[T]{first, second}, key), func(item T) (TOrdered, error) {
+		return key(item), nil
+	})
+}
+
+// SortedIntersect yields one item from first for each key present in both
+// enumerators. Both inputs must be sorted ascending by key.
+func SortedIntersect[T any, TOrdered constraints.Ordered](
+	first Enumerator[T],
+	second Enumerator[T],
+	key func(T) TOrdered,
+) Enumerator[T] {
+	return &sortedSetEnumerator[T, TOrdered]{
+		first:     joinCursor[T]{base: first},
+		second:    joinCursor[T]{base: second},
+		key:       key,
+		intersect: true,
+	}
+}
+
+// SortedExcept yields one item from first for each key that is absent from
+// second. Both inputs must be sorted ascending by key.
+func SortedExcept[T any, TOrdered constraints.Ordered](
+	first Enumerator[T],
+	second Enumerator[T],
+	key func(T) TOrdered,
+) Enumerator[T] {
+	return &sortedSetEnumerator[T, TOrdered]{
+		first:  joinCursor[T]{base: first},
+		second: joinCursor[T]{base: second},
+		key:    key,
+	}
+}
+
+type sortedSetEnumerator[T any, TOrdered constraints.Ordered] struct {
+	first     joinCursor[T]
+	second    joinCursor[T]
+	key       func(T) TOrdered
+	intersect bool
+	current   T
+	err       error
+	pending   error
+	started   bool
+	done      bool
+}
+
+func (e *sortedSetEnumerator[T, TOrdered]) MoveNext() bool {
+	if e.done {
+		return false
+	}
+	if e.pending != nil {
+		return e.fail(e.pending)
+	}
+
+	if !e.started {
+		e.started = true
+		if err := e.first.advance(); err != nil {
+			return e.fail(err)
+		}
+		if err := e.second.advance(); err != nil {
+			return e.fail(err)
+		}
+	}
+
+	for e.first.ok {
+		item := e.first.item
+		k := e.key(item)
+
+		// Advance second up to the current key.
+		for e.second.ok && e.key(e.second.item) < k {
+			if err := e.second.advance(); err != nil {
+				return e.fail(err)
+			}
+		}
+		matched := e.second.ok && e.key(e.second.item) == k
+
+		// Skip the remaining duplicates of this key in first. The item is
+		// already decided, so a failure here is reported after yielding it.
+		for e.first.ok && e.key(e.first.item) == k {
+			if err := e.first.advance(); err != nil {
+				e.pending = err
+				break
+			}
+		}
+
+		if e.pending != nil && matched != e.intersect {
+			return e.fail(e.pending)
+		}
+		if matched == e.intersect {
+			e.current = item
+			return true
+		}
+	}
+
+	e.done = true
+	return false
+}
+
+func (e *sortedSetEnumerator[T, TOrdered]) fail(err error) bool {
+	e.err = err
+	e.done = true
+	return false
+}
+
+func (e *sortedSetEnumerator[T, TOrdered]) Current() (T, error) {
+	return e.current, e.err
+}
+
+func (e *sortedSetEnumerator[T, TOrdered]) Err() error {
+	return e.err
+}
+
+func (e *sortedSetEnumerator[T, TOrdered]) Dispose() {
+	e.first.base.Dispose()
+	e.second.base.Dispose()
+}
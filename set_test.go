@@ -0,0 +1,135 @@
+package enumerators_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func identity(i int) int {
+	return i
+}
+
+func TestUnion(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Union(enumerators.Slice([]int{3, 1, 3}), enumerators.Slice([]int{2, 1, 4})))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 1, 2, 4}, result)
+}
+
+func TestIntersect(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Intersect(enumerators.Slice([]int{3, 1, 3, 2}), enumerators.Slice([]int{2, 3, 5})))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 2}, result)
+}
+
+func TestExcept(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Except(enumerators.Slice([]int{3, 1, 3, 2}), enumerators.Slice([]int{2, 5})))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 1}, result)
+}
+
+func TestExcept_DisposesUnreadSecond(t *testing.T) {
+	// Arrange
+	disposed := false
+	second := enumerators.Cleanup(enumerators.Slice([]int{1}), func() { disposed = true })
+
+	// Act
+	enumerators.Except(enumerators.Empty[int](), second).Dispose()
+
+	// Assert
+	assert.True(t, disposed)
+}
+
+func TestIntersect_BubbleError(t *testing.T) {
+	// Act
+	_, err := enumerators.ToSlice(enumerators.Intersect(enumerators.Slice([]int{1}), enumerators.Error[int](errors.New("bubble error"))))
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+}
+
+func TestIntersect_BubbleErrorWithEmptyFirst(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Intersect(enumerators.Empty[int](), enumerators.Error[int](errors.New("bubble error"))))
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+	assert.Empty(t, result)
+}
+
+func TestUnion_BubbleError(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Union(enumerators.Error[int](errors.New("bubble error")), enumerators.Slice([]int{1, 2})))
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+	assert.Empty(t, result)
+}
+
+func TestSortedUnion(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.SortedUnion(enumerators.Slice([]int{1, 3, 3, 5}), enumerators.Slice([]int{2, 3, 6}), identity))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3, 5, 6}, result)
+}
+
+func TestSortedIntersect(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.SortedIntersect(enumerators.Slice([]int{1, 3, 3, 5, 7}), enumerators.Slice([]int{2, 3, 5, 5, 6}), identity))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 5}, result)
+}
+
+func TestSortedExcept(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.SortedExcept(enumerators.Slice([]int{1, 1, 3, 5, 7}), enumerators.Slice([]int{2, 3, 7}), identity))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 5}, result)
+}
+
+// failAfter yields items and then fails instead of ending.
+func failAfter(items ...int) enumerators.Enumerator[int] {
+	index := 0
+	return enumerators.Generate(func() (int, bool, error) {
+		if index == len(items) {
+			return 0, false, errors.New("bubble error")
+		}
+		index++
+		return items[index-1], true, nil
+	})
+}
+
+func TestSortedIntersect_YieldsBeforeError(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.SortedIntersect(failAfter(1, 2), enumerators.Slice([]int{2}), identity))
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+	assert.Equal(t, []int{2}, result)
+}
+
+func TestSortedExcept_YieldsBeforeError(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.SortedExcept(failAfter(1, 2), enumerators.Slice([]int{5}), identity))
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+	assert.Equal(t, []int{1, 2}, result)
+}
@@ -0,0 +1,30 @@
+package enumerators
+
+// Sized is implemented by enumerators that know how many items remain. Len
+// returns false when the count is unknown.
+type Sized interface {
+	Len() (int, bool)
+}
+
+// lengthOf returns the number of items remaining in the enumerator, if known.
+func lengthOf[T any](enumerator Enumerator[T]) (int, bool) {
+	if sized, ok := enumerator.(Sized); ok {
+		return sized.Len()
+	}
+	return 0, false
+}
+
+// randomAccess exposes the remaining items of slice- and range-backed
+// enumerators by index so operators can skip enumerating them.
+func randomAccess[T any](enumerator Enumerator[T]) (int, func(i int) T, bool) {
+	switch e := enumerator.(type) {
+	case *SliceEnumerator[T]:
+		remaining := e.remaining()
+		return len(remaining), func(i int) T { return remaining[i] }, true
+	case *rangeEnumerator[T]:
+		n, _ := e.Len()
+		start := e.start
+		return n, func(i int) T { return e.factory(start + i) }, true
+	}
+	return 0, nil, false
+}
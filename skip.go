@@ -0,0 +1,118 @@
+package enumerators
+
+type skipEnumerator[T any] struct {
+	base    Enumerator[T]
+	count   int
+	current T
+	err     error
+}
+
+func (e *skipEnumerator[T]) MoveNext() bool {
+	for {
+		if !e.base.MoveNext() {
+			e.err = e.base.Err()
+			return false
+		}
+
+		item, err := e.base.Current()
+		if err != nil {
+			e.err = err
+			return false
+		}
+
+		if e.count > 0 {
+			e.count--
+			continue
+		}
+
+		e.current = item
+		return true
+	}
+}
+
+func (e *skipEnumerator[T]) Current() (T, error) {
+	return e.current, e.err
+}
+
+func (e *skipEnumerator[T]) Err() error {
+	return e.err
+}
+
+func (e *skipEnumerator[T]) Dispose() {
+	e.base.Dispose()
+}
+
+// Skip bypasses the first count items and yields the rest. Slice- and
+// range-backed enumerators are skipped without enumerating.
+func Skip[T any](enumerator Enumerator[T], count int) Enumerator[T] {
+	switch e := enumerator.(type) {
+	case *SliceEnumerator[T]:
+		remaining := e.remaining()
+		return Slice(remaining[min(max(count, 0), len(remaining)):])
+	case *rangeEnumerator[T]:
+		n, _ := e.Len()
+		skipped := min(max(count, 0), n)
+		return Range(e.start+skipped, n-skipped, e.factory)
+	}
+	return &skipEnumerator[T]{
+		base:  enumerator,
+		count: count,
+	}
+}
+
+type skipLastEnumerator[T any] struct {
+	base    Enumerator[T]
+	count   int
+	queue   []T
+	current T
+	err     error
+}
+
+func (e *skipLastEnumerator[T]) MoveNext() bool {
+	for {
+		if !e.base.MoveNext() {
+			e.err = e.base.Err()
+			return false
+		}
+
+		item, err := e.base.Current()
+		if err != nil {
+			e.err = err
+			return false
+		}
+
+		e.queue = append(e.queue, item)
+		if len(e.queue) <= e.count {
+			continue
+		}
+
+		e.current = e.queue[0]
+		e.queue = e.queue[1:]
+		return true
+	}
+}
+
+func (e *skipLastEnumerator[T]) Current() (T, error) {
+	return e.current, e.err
+}
+
+func (e *skipLastEnumerator[T]) Err() error {
+	return e.err
+}
+
+func (e *skipLastEnumerator[T]) Dispose() {
+	e.base.Dispose()
+	e.queue = nil
+}
+
+// SkipLast yields every item except the final count, buffering at most count
+// items.
+func SkipLast[T any](enumerator Enumerator[T], count int) Enumerator[T] {
+	if count < 0 {
+		count = 0
+	}
+	return &skipLastEnumerator[T]{
+		base:  enumerator,
+		count: count,
+	}
+}
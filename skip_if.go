@@ -10,6 +10,7 @@ type skipIfEnumerator[T any] struct {
 func (e *skipIfEnumerator[T]) MoveNext() bool {
 	for {
 		if !e.base.MoveNext() {
+			e.err = e.base.Err()
 			return false
 		}
 
@@ -0,0 +1,52 @@
+package enumerators
+
+type skipWhileEnumerator[T any] struct {
+	base      Enumerator[T]
+	condition func(T) bool
+	current   T
+	err       error
+	skipped   bool
+}
+
+func (e *skipWhileEnumerator[T]) MoveNext() bool {
+	for {
+		if !e.base.MoveNext() {
+			e.err = e.base.Err()
+			return false
+		}
+
+		item, err := e.base.Current()
+		if err != nil {
+			e.err = err
+			return false
+		}
+
+		if !e.skipped && e.condition(item) {
+			continue
+		}
+
+		e.skipped = true
+		e.current = item
+		return true
+	}
+}
+
+func (e *skipWhileEnumerator[T]) Current() (T, error) {
+	return e.current, e.err
+}
+
+func (e *skipWhileEnumerator[T]) Err() error {
+	return e.err
+}
+
+func (e *skipWhileEnumerator[T]) Dispose() {
+	e.base.Dispose()
+}
+
+// skip items while the condition is true and take everything after the first item that fails it
+func SkipWhile[T any](enumerator Enumerator[T], condition func(T) bool) Enumerator[T] {
+	return &skipWhileEnumerator[T]{
+		base:      enumerator,
+		condition: condition,
+	}
+}
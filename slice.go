@@ -28,6 +28,17 @@ func (enumerator *SliceEnumerator[T]) Dispose() {
 	// no-op
 }
 
+// Len returns the number of items not yet enumerated.
+func (e *SliceEnumerator[T]) Len() (int, bool) {
+	return len(e.remaining()), true
+}
+
+// remaining returns the part of the slice that has not been enumerated yet.
+// An empty slice stays non-nil.
+func (e *SliceEnumerator[T]) remaining() []T {
+	return e.slice[min(e.cursor+1, len(e.slice)):]
+}
+
 func Slice[T any](slice []T) Enumerator[T] {
 	return &SliceEnumerator[T]{
 		slice:  slice,
@@ -38,10 +49,13 @@ func Slice[T any](slice []T) Enumerator[T] {
 func ToSlice[T any](enumerator Enumerator[T]) ([]T, error) {
 	defer enumerator.Dispose()
 	if sliceEnum, ok := enumerator.(*SliceEnumerator[T]); ok {
-		return sliceEnum.slice, nil
+		return sliceEnum.remaining(), nil
 	}
 
 	var slice []T
+	if n, ok := lengthOf(enumerator); ok && n > 0 {
+		slice = make([]T, 0, n)
+	}
 	for enumerator.MoveNext() {
 		item, err := enumerator.Current()
 		if err != nil {
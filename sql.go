@@ -0,0 +1,117 @@
+package enumerators
+
+import (
+	"database/sql"
+	"reflect"
+	"strings"
+)
+
+type rowsEnumerator[T any] struct {
+	rows     *sql.Rows
+	scan     func(*sql.Rows) (T, error)
+	current  T
+	err      error
+	done     bool
+	disposed bool
+}
+
+func (e *rowsEnumerator[T]) MoveNext() bool {
+	if e.done || e.disposed {
+		return false
+	}
+
+	if !e.rows.Next() {
+		e.err = e.rows.Err()
+		e.done = true
+		return false
+	}
+
+	item, err := e.scan(e.rows)
+	if err != nil {
+		e.err = err
+		e.done = true
+		return false
+	}
+	e.current = item
+	return true
+}
+
+func (e *rowsEnumerator[T]) Current() (T, error) {
+	return e.current, e.err
+}
+
+func (e *rowsEnumerator[T]) Err() error {
+	return e.err
+}
+
+// Dispose closes the rows, releasing the connection even when enumeration
+// was abandoned early.
+func (e *rowsEnumerator[T]) Dispose() {
+	if e.disposed {
+		return
+	}
+	e.disposed = true
+	if err := e.rows.Close(); err != nil && e.err == nil {
+		e.err = err
+	}
+}
+
+// FromRows yields one T per row using the scan function. Errors from
+// rows.Err are reported by Err, and the rows are closed on Dispose.
+func FromRows[T any](rows *sql.Rows, scan func(*sql.Rows) (T, error)) Enumerator[T] {
+	return &rowsEnumerator[T]{rows: rows, scan: scan}
+}
+
+// ScanStructs yields one T per row, matching columns to fields by their `db`
+// tag or, case-insensitively, by field name. Columns without a matching field
+// are discarded. The rows are closed on Dispose.
+func ScanStructs[T any](rows *sql.Rows) Enumerator[T] {
+	fields, err := structFields(reflect.TypeFor[T](), "db")
+	if err != nil {
+		rows.Close()
+		return Error[T](err)
+	}
+
+	var columns [][]int
+	return FromRows(rows, func(rows *sql.Rows) (T, error) {
+		var item T
+		if columns == nil {
+			names, err := rows.Columns()
+			if err != nil {
+				return item, err
+			}
+			columns = matchColumns(names, fields)
+		}
+
+		value := reflect.ValueOf(&item).Elem()
+		targets := make([]any, len(columns))
+		for i, index := range columns {
+			if index == nil {
+				targets[i] = new(any)
+				continue
+			}
+			targets[i] = value.FieldByIndex(index).Addr().Interface()
+		}
+
+		err := rows.Scan(targets...)
+		return item, err
+	})
+}
+
+// matchColumns returns the field index for each column, or nil when no field
+// matches. Exact names win over case-insensitive ones.
+func matchColumns(names []string, fields []structField) [][]int {
+	columns := make([][]int, len(names))
+	for i, name := range names {
+		for _, field := range fields {
+			if field.name == name {
+				columns[i] = field.index
+				break
+			}
+			if columns[i] == nil && strings.EqualFold(field.name, name) {
+				columns[i] = field.index
+			}
+		}
+	}
+	return columns
+}
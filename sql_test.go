@@ -0,0 +1,184 @@
+package enumerators_test
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDriver serves fixed result sets keyed by the query text.
+type fakeDriver struct{}
+
+type fakeConn struct{}
+
+type fakeStmt struct {
+	query string
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+	failAt  int
+	cursor  int
+}
+
+var fakeRowsClosed atomic.Int32
+
+var fakeResults = map[string]func() *fakeRows{
+	"users": func() *fakeRows {
+		return &fakeRows{
+			columns: []string{"id", "NAME", "extra"},
+			values:  [][]driver.Value{{int64(1), "ada", "x"}, {int64(2), "bob", "y"}, {int64(3), "cy", "z"}},
+			failAt:  -1,
+		}
+	},
+	"failing": func() *fakeRows {
+		return &fakeRows{
+			columns: []string{"id"},
+			values:  [][]driver.Value{{int64(1)}, {int64(2)}},
+			failAt:  1,
+		}
+	},
+}
+
+func init() {
+	sql.Register("enumerators-fake", fakeDriver{})
+}
+
+func (fakeDriver) Open(name string) (driver.Conn, error) {
+	return fakeConn{}, nil
+}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return fakeStmt{query: query}, nil
+}
+
+func (fakeConn) Close() error {
+	return nil
+}
+
+func (fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("not supported")
+}
+
+func (s fakeStmt) Close() error {
+	return nil
+}
+
+func (s fakeStmt) NumInput() int {
+	return 0
+}
+
+func (s fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return nil, errors.New("not supported")
+}
+
+func (s fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	return fakeResults[s.query](), nil
+}
+
+func (r *fakeRows) Columns() []string {
+	return r.columns
+}
+
+func (r *fakeRows) Close() error {
+	fakeRowsClosed.Add(1)
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.cursor == r.failAt {
+		return errors.New("connection reset")
+	}
+	if r.cursor >= len(r.values) {
+		return io.EOF
+	}
+	copy(dest, r.values[r.cursor])
+	r.cursor++
+	return nil
+}
+
+type user struct {
+	ID   int `db:"id"`
+	Name string
+}
+
+func query(t *testing.T, q string) *sql.Rows {
+	db, err := sql.Open("enumerators-fake", "")
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	rows, err := db.Query(q)
+	require.NoError(t, err)
+	return rows
+}
+
+func TestFromRows(t *testing.T) {
+	// Arrange
+	rows := query(t, "users")
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.FromRows(rows, func(rows *sql.Rows) (string, error) {
+		var id int
+		var name, extra string
+		err := rows.Scan(&id, &name, &extra)
+		return name, err
+	}))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ada", "bob", "cy"}, result)
+}
+
+func TestFromRows_ReportsRowsErr(t *testing.T) {
+	// Arrange
+	rows := query(t, "failing")
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.FromRows(rows, func(rows *sql.Rows) (int, error) {
+		var id int
+		err := rows.Scan(&id)
+		return id, err
+	}))
+
+	// Assert
+	assert.EqualError(t, err, "connection reset")
+	assert.Equal(t, []int{1}, result)
+}
+
+func TestFromRows_DisposeEarlyClosesRows(t *testing.T) {
+	// Arrange
+	rows := query(t, "users")
+	before := fakeRowsClosed.Load()
+
+	// Act
+	first, err := enumerators.First(enumerators.ScanStructs[user](rows))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, user{ID: 1, Name: "ada"}, first)
+	assert.Equal(t, before+1, fakeRowsClosed.Load())
+}
+
+func TestScanStructs_IntoChunks(t *testing.T) {
+	// Arrange
+	rows := query(t, "users")
+
+	// Act
+	result, err := enumerators.Collect(enumerators.ChunkByCount(enumerators.ScanStructs[user](rows), 2))
+
+	// Assert
+	assert.NoError(t, err)
+	expected := [][]user{
+		{{ID: 1, Name: "ada"}, {ID: 2, Name: "bob"}},
+		{{ID: 3, Name: "cy"}},
+	}
+	assert.Equal(t, expected, result)
+}
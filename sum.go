@@ -24,5 +24,9 @@ func Sum[T any, TSum constraints.Ordered](enumerator Enumerator[T], selector fun
 		sum += value
 	}
 
+	if err := enumerator.Err(); err != nil {
+		var zero TSum
+		return zero, err
+	}
 	return sum, nil
 }
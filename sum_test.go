@@ -0,0 +1,38 @@
+package enumerators_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSum(t *testing.T) {
+	// Arrange
+	source := enumerators.Slice([]int{1, 2, 3, 4})
+
+	// Act
+	sum, err := enumerators.Sum(source, func(i int) (int, error) { return i, nil })
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 10, sum)
+}
+
+func TestSum_BubbleError(t *testing.T) {
+	// Arrange
+	source := enumerators.Map(enumerators.Slice([]int{1, 2, 3}), func(i int) (int, error) {
+		if i == 3 {
+			return 0, errors.New("bubble error")
+		}
+		return i, nil
+	})
+
+	// Act
+	sum, err := enumerators.Sum(source, func(i int) (int, error) { return i, nil })
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+	assert.Zero(t, sum)
+}
@@ -0,0 +1,91 @@
+package enumerators
+
+type takeEnumerator[T any] struct {
+	base      Enumerator[T]
+	remaining int
+	current   T
+	err       error
+	disposed  bool
+}
+
+func (e *takeEnumerator[T]) MoveNext() bool {
+	if e.remaining <= 0 {
+		// release the source as soon as the limit is reached
+		e.Dispose()
+		return false
+	}
+
+	if !e.base.MoveNext() {
+		e.err = e.base.Err()
+		e.remaining = 0
+		return false
+	}
+
+	item, err := e.base.Current()
+	if err != nil {
+		e.err = err
+		e.remaining = 0
+		return false
+	}
+
+	e.remaining--
+	e.current = item
+	return true
+}
+
+func (e *takeEnumerator[T]) Current() (T, error) {
+	return e.current, e.err
+}
+
+func (e *takeEnumerator[T]) Err() error {
+	return e.err
+}
+
+func (e *takeEnumerator[T]) Dispose() {
+	if !e.disposed {
+		e.disposed = true
+		e.base.Dispose()
+	}
+}
+
+// Take yields at most count items and never pulls past them.
+func Take[T any](enumerator Enumerator[T], count int) Enumerator[T] {
+	return &takeEnumerator[T]{
+		base:      enumerator,
+		remaining: count,
+	}
+}
+
+// TakeLast yields the final count items. The source is consumed on the first
+// call to MoveNext while buffering at most count items.
+func TakeLast[T any](enumerator Enumerator[T], count int) Enumerator[T] {
+	return &deferredEnumerator[T]{
+		base: enumerator,
+		load: func() ([]T, error) {
+			if count <= 0 {
+				return nil, nil
+			}
+
+			ring := make([]T, 0, count)
+			next := 0
+			for enumerator.MoveNext() {
+				item, err := enumerator.Current()
+				if err != nil {
+					return nil, err
+				}
+
+				if len(ring) < count {
+					ring = append(ring, item)
+					continue
+				}
+				ring[next] = item
+				next = (next + 1) % count
+			}
+
+			if err := enumerator.Err(); err != nil {
+				return nil, err
+			}
+			return append(ring[next:], ring[:next]...), nil
+		},
+	}
+}
@@ -0,0 +1,93 @@
+package enumerators_test
+
+import (
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func naturals(disposed *bool) enumerators.Enumerator[int] {
+	i := 0
+	return enumerators.GenerateAndDispose(func() (int, bool, error) {
+		i++
+		return i, true, nil
+	}, func() { *disposed = true })
+}
+
+func TestTakeWhile_StopsOnInfiniteSource(t *testing.T) {
+	// Arrange
+	disposed := false
+	taken := enumerators.TakeWhile(naturals(&disposed), func(i int) bool { return i < 4 })
+
+	// Act
+	var result []int
+	for taken.MoveNext() {
+		item, _ := taken.Current()
+		result = append(result, item)
+	}
+
+	// Assert
+	assert.Equal(t, []int{1, 2, 3}, result)
+	assert.True(t, disposed)
+	assert.False(t, taken.MoveNext())
+}
+
+func TestSkipWhile(t *testing.T) {
+	// Arrange
+	source := enumerators.Slice([]int{1, 2, 5, 1, 6})
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.SkipWhile(source, func(i int) bool { return i < 3 }))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{5, 1, 6}, result)
+}
+
+func TestTake_StopsOnInfiniteSource(t *testing.T) {
+	// Arrange
+	disposed := false
+	taken := enumerators.Take(naturals(&disposed), 3)
+
+	// Act
+	var result []int
+	for taken.MoveNext() {
+		item, _ := taken.Current()
+		result = append(result, item)
+	}
+
+	// Assert
+	assert.Equal(t, []int{1, 2, 3}, result)
+	assert.True(t, disposed)
+}
+
+func TestSkip(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Skip(enumerators.Slice([]int{1, 2, 3, 4}), 2))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{3, 4}, result)
+}
+
+func TestTakeLast(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.TakeLast(enumerators.Slice([]int{1, 2, 3, 4, 5}), 3))
+	short, shortErr := enumerators.ToSlice(enumerators.TakeLast(enumerators.Slice([]int{1, 2}), 3))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NoError(t, shortErr)
+	assert.Equal(t, []int{3, 4, 5}, result)
+	assert.Equal(t, []int{1, 2}, short)
+}
+
+func TestSkipLast(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.SkipLast(enumerators.Slice([]int{1, 2, 3, 4, 5}), 2))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 2, 3}, result)
+}
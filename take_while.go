@@ -5,27 +5,37 @@ type takeWhileEnumerator[T any] struct {
 	condition func(T) bool
 	current   T
 	err       error
+	done      bool
+	disposed  bool
 }
 
 func (e *takeWhileEnumerator[T]) MoveNext() bool {
-	for {
-		if !e.base.MoveNext() {
-			return false
-		}
-
-		item, err := e.base.Current()
-		if err != nil {
-			e.err = err
-			return false
-		}
-
-		if !e.condition(item) {
-			continue
-		}
-
-		e.current = item
-		return true
+	if e.done {
+		return false
 	}
+
+	if !e.base.MoveNext() {
+		e.err = e.base.Err()
+		e.done = true
+		return false
+	}
+
+	item, err := e.base.Current()
+	if err != nil {
+		e.err = err
+		e.done = true
+		return false
+	}
+
+	if !e.condition(item) {
+		// stop pulling and release the source right away
+		e.done = true
+		e.Dispose()
+		return false
+	}
+
+	e.current = item
+	return true
 }
 
 func (e *takeWhileEnumerator[T]) Current() (T, error) {
@@ -37,10 +47,13 @@ func (e *takeWhileEnumerator[T]) Err() error {
 }
 
 func (e *takeWhileEnumerator[T]) Dispose() {
-	e.base.Dispose()
+	if !e.disposed {
+		e.disposed = true
+		e.base.Dispose()
+	}
 }
 
-// take the item if the contition is true
+// take items while the condition is true and stop at the first item that fails it
 func TakeWhile[T any](enumerator Enumerator[T], condition func(T) bool) Enumerator[T] {
 	return &takeWhileEnumerator[T]{
 		base:      enumerator,
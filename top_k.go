@@ -0,0 +1,75 @@
+package enumerators
+
+import "container/heap"
+
+// ranked tags an item with its position in the source so ties are resolved
+// in favour of the item seen first.
+type ranked[T any] struct {
+	item     T
+	sequence int
+}
+
+// TopK yields the k largest items according to less, largest first. Only k
+// items are held in memory. The source is consumed on the first call to
+// MoveNext. Equal items are kept and yielded in source order.
+func TopK[T any](enumerator Enumerator[T], k int, less func(a, b T) bool) Enumerator[T] {
+	return selectK(enumerator, k, less)
+}
+
+// BottomK yields the k smallest items according to less, smallest first. Only
+// k items are held in memory. The source is consumed on the first call to
+// MoveNext. Equal items are kept and yielded in source order.
+func BottomK[T any](enumerator Enumerator[T], k int, less func(a, b T) bool) Enumerator[T] {
+	return selectK(enumerator, k, func(a, b T) bool { return less(b, a) })
+}
+
+// selectK keeps the k best items, where an item is better than another when
+// it is greater according to less.
+func selectK[T any](enumerator Enumerator[T], k int, less func(a, b T) bool) Enumerator[T] {
+	// worse orders the heap so the item to evict next is at the root.
+	worse := func(a, b ranked[T]) bool {
+		if less(a.item, b.item) {
+			return true
+		}
+		if less(b.item, a.item) {
+			return false
+		}
+		return a.sequence > b.sequence
+	}
+
+	return &deferredEnumerator[T]{
+		base: enumerator,
+		load: func() ([]T, error) {
+			if k <= 0 {
+				return nil, nil
+			}
+
+			q := &priorityQueue[ranked[T]]{less: worse}
+			for sequence := 0; enumerator.MoveNext(); sequence++ {
+				item, err := enumerator.Current()
+				if err != nil {
+					return nil, err
+				}
+
+				candidate := ranked[T]{item: item, sequence: sequence}
+				if q.Len() < k {
+					heap.Push(q, queueItem[ranked[T]]{item: candidate})
+					continue
+				}
+				if less(q.items[0].item.item, item) {
+					q.items[0] = queueItem[ranked[T]]{item: candidate}
+					heap.Fix(q, 0)
+				}
+			}
+			if err := enumerator.Err(); err != nil {
+				return nil, err
+			}
+
+			result := make([]T, q.Len())
+			for i := len(result) - 1; i >= 0; i-- {
+				result[i] = heap.Pop(q).(queueItem[ranked[T]]).item.item
+			}
+			return result, nil
+		},
+	}
+}
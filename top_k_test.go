@@ -0,0 +1,56 @@
+package enumerators_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTopK(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.TopK(enumerators.Slice([]int{5, 1, 9, 3, 7, 9}), 3, func(a, b int) bool { return a < b }))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{9, 9, 7}, result)
+}
+
+func TestBottomK(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.BottomK(enumerators.Slice([]int{5, 1, 9, 3, 7}), 2, func(a, b int) bool { return a < b }))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{1, 3}, result)
+}
+
+func TestTopK_StableTies(t *testing.T) {
+	// Arrange
+	source := enumerators.Slice(unsortedRecords())
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.BottomK(source, 3, byKey))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []record{{Key: 1, Order: 3}, {Key: 1, Order: 9}, {Key: 2, Order: 7}}, result)
+}
+
+func TestTopK_FewerThanK(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.TopK(enumerators.Slice([]int{2, 1}), 5, func(a, b int) bool { return a < b }))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 1}, result)
+}
+
+func TestTopK_BubbleError(t *testing.T) {
+	// Act
+	_, err := enumerators.ToSlice(enumerators.TopK(enumerators.Error[int](errors.New("bubble error")), 2, func(a, b int) bool { return a < b }))
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+}
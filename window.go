@@ -0,0 +1,100 @@
+package enumerators
+
+import "errors"
+
+type windowEnumerator[T any] struct {
+	base    Enumerator[T]
+	size    int
+	step    int
+	buffer  []T
+	current []T
+	err     error
+	started bool
+	done    bool
+}
+
+func (e *windowEnumerator[T]) MoveNext() bool {
+	if e.done {
+		return false
+	}
+
+	if e.started {
+		if e.step < e.size {
+			e.buffer = append(e.buffer[:0], e.buffer[e.step:]...)
+		} else {
+			e.buffer = e.buffer[:0]
+			for i := e.size; i < e.step; i++ {
+				if !e.pull(false) {
+					return false
+				}
+			}
+		}
+	}
+	e.started = true
+
+	for len(e.buffer) < e.size {
+		if !e.pull(true) {
+			return false
+		}
+	}
+
+	e.current = make([]T, e.size)
+	copy(e.current, e.buffer)
+	return true
+}
+
+// pull advances the source, optionally keeping the item in the buffer.
+func (e *windowEnumerator[T]) pull(keep bool) bool {
+	if !e.base.MoveNext() {
+		e.err = e.base.Err()
+		e.done = true
+		return false
+	}
+
+	item, err := e.base.Current()
+	if err != nil {
+		e.err = err
+		e.done = true
+		return false
+	}
+
+	if keep {
+		e.buffer = append(e.buffer, item)
+	}
+	return true
+}
+
+func (e *windowEnumerator[T]) Current() ([]T, error) {
+	return e.current, e.err
+}
+
+func (e *windowEnumerator[T]) Err() error {
+	return e.err
+}
+
+func (e *windowEnumerator[T]) Dispose() {
+	e.base.Dispose()
+}
+
+// Window yields windows of size items, advancing step items between windows.
+// Windows overlap when step < size, are disjoint when step == size and skip
+// items when step > size. A trailing partial window is not emitted.
+func Window[T any](enumerator Enumerator[T], size int, step int) Enumerator[[]T] {
+	if size < 1 || step < 1 {
+		enumerator.Dispose()
+		return Error[[]T](errors.New("window size and step must be positive"))
+	}
+	return &windowEnumerator[T]{
+		base:   enumerator,
+		size:   size,
+		step:   step,
+		buffer: make([]T, 0, size),
+	}
+}
+
+// Pairwise yields each item paired with the item that follows it.
+func Pairwise[T any](enumerator Enumerator[T]) Enumerator[Pair[T, T]] {
+	return Map(Window(enumerator, 2, 1), func(window []T) (Pair[T, T], error) {
+		return Pair[T, T]{First: window[0], Second: window[1]}, nil
+	})
+}
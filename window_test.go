@@ -0,0 +1,83 @@
+package enumerators_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWindow_Sliding(t *testing.T) {
+	// Arrange
+	source := enumerators.Slice([]int{1, 2, 3, 4, 5})
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Window(source, 3, 1))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{1, 2, 3}, {2, 3, 4}, {3, 4, 5}}, result)
+}
+
+func TestWindow_Tumbling(t *testing.T) {
+	// Arrange
+	source := enumerators.Slice([]int{1, 2, 3, 4, 5})
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Window(source, 2, 2))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}}, result)
+}
+
+func TestWindow_Sampled(t *testing.T) {
+	// Arrange
+	source := enumerators.Slice([]int{1, 2, 3, 4, 5, 6, 7, 8})
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Window(source, 2, 3))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{1, 2}, {4, 5}, {7, 8}}, result)
+}
+
+func TestWindow_InvalidArguments(t *testing.T) {
+	// Act
+	_, err := enumerators.ToSlice(enumerators.Window(enumerators.Slice([]int{1}), 0, 1))
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestWindow_BubbleError(t *testing.T) {
+	// Arrange
+	source := enumerators.Map(enumerators.Slice([]int{1, 2, 3, 4}), func(i int) (int, error) {
+		if i == 4 {
+			return 0, errors.New("bubble error")
+		}
+		return i, nil
+	})
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Window(source, 2, 1))
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+	assert.Equal(t, [][]int{{1, 2}, {2, 3}}, result)
+}
+
+func TestPairwise(t *testing.T) {
+	// Arrange
+	source := enumerators.Slice([]int{1, 2, 3})
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Pairwise(source))
+
+	// Assert
+	assert.NoError(t, err)
+	expected := []enumerators.Pair[int, int]{{First: 1, Second: 2}, {First: 2, Second: 3}}
+	assert.Equal(t, expected, result)
+}
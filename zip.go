@@ -0,0 +1,208 @@
+package enumerators
+
+type zipEnumerator[A any, B any] struct {
+	first      Enumerator[A]
+	second     Enumerator[B]
+	longest    bool
+	current    Joined[A, B]
+	err        error
+	firstDone  bool
+	secondDone bool
+	done       bool
+	disposed   bool
+}
+
+func (e *zipEnumerator[A, B]) MoveNext() bool {
+	if e.done {
+		return false
+	}
+
+	// An exhausted side is never pulled again, so sources that restart or
+	// repeat side effects on MoveNext stay quiet once they have ended.
+	var a A
+	hasA := false
+	if !e.firstDone {
+		var err error
+		a, hasA, err = zipNext(e.first)
+		if err != nil {
+			return e.fail(err)
+		}
+		e.firstDone = !hasA
+	}
+
+	// Without a first item the shortest zip is over; don't pull from second.
+	if !hasA && !e.longest {
+		return e.finish()
+	}
+
+	var b B
+	hasB := false
+	if !e.secondDone {
+		var err error
+		b, hasB, err = zipNext(e.second)
+		if err != nil {
+			return e.fail(err)
+		}
+		e.secondDone = !hasB
+	}
+
+	if !hasB && (!e.longest || !hasA) {
+		return e.finish()
+	}
+
+	e.current = Joined[A, B]{Left: a, Right: b, HasLeft: hasA, HasRight: hasB}
+	return true
+}
+
+// zipNext pulls the next item, reporting whether one was available.
+func zipNext[T any](enumerator Enumerator[T]) (T, bool, error) {
+	var zero T
+	if !enumerator.MoveNext() {
+		return zero, false, enumerator.Err()
+	}
+
+	item, err := enumerator.Current()
+	if err != nil {
+		return zero, false, err
+	}
+	return item, true, nil
+}
+
+// finish ends the enumeration and releases both sources right away.
+func (e *zipEnumerator[A, B]) finish() bool {
+	e.done = true
+	e.Dispose()
+	return false
+}
+
+func (e *zipEnumerator[A, B]) fail(err error) bool {
+	e.err = err
+	e.done = true
+	return false
+}
+
+func (e *zipEnumerator[A, B]) Current() (Joined[A, B], error) {
+	return e.current, e.err
+}
+
+func (e *zipEnumerator[A, B]) Err() error {
+	return e.err
+}
+
+func (e *zipEnumerator[A, B]) Dispose() {
+	if !e.disposed {
+		e.disposed = true
+		e.first.Dispose()
+		e.second.Dispose()
+	}
+}
+
+// Zip walks two enumerators in lockstep, combining their items. It stops when
+// either enumerator is exhausted and disposes both.
+func Zip[A any, B any, U any](first Enumerator[A], second Enumerator[B], combine func(A, B) (U, error)) Enumerator[U] {
+	zipped := &zipEnumerator[A, B]{first: first, second: second}
+	return Map[Joined[A, B]](zipped, func(j Joined[A, B]) (U, error) {
+		return combine(j.Left, j.Right)
+	})
+}
+
+// ZipLongest walks two enumerators in lockstep until both are exhausted. Once
+// one side runs out its value is the zero value and its Has flag is false.
+func ZipLongest[A any, B any](first Enumerator[A], second Enumerator[B]) Enumerator[Joined[A, B]] {
+	return &zipEnumerator[A, B]{first: first, second: second, longest: true}
+}
+
+type zipNEnumerator[T any] struct {
+	enumerators []Enumerator[T]
+	current     []T
+	err         error
+	done        bool
+	disposed    bool
+}
+
+func (e *zipNEnumerator[T]) MoveNext() bool {
+	if e.done || len(e.enumerators) == 0 {
+		return false
+	}
+
+	current := make([]T, len(e.enumerators))
+	for i, enumerator := range e.enumerators {
+		item, ok, err := zipNext(enumerator)
+		if err != nil {
+			e.err = err
+			e.done = true
+			return false
+		}
+		if !ok {
+			e.done = true
+			e.Dispose()
+			return false
+		}
+		current[i] = item
+	}
+
+	e.current = current
+	return true
+}
+
+func (e *zipNEnumerator[T]) Current() ([]T, error) {
+	return e.current, e.err
+}
+
+func (e *zipNEnumerator[T]) Err() error {
+	return e.err
+}
+
+func (e *zipNEnumerator[T]) Dispose() {
+	if !e.disposed {
+		e.disposed = true
+		for _, enumerator := range e.enumerators {
+			enumerator.Dispose()
+		}
+	}
+}
+
+// ZipN walks any number of enumerators in lockstep, yielding one slice per
+// step. It stops when any enumerator is exhausted and disposes all of them.
+func ZipN[T any](enumerators []Enumerator[T]) Enumerator[[]T] {
+	return &zipNEnumerator[T]{enumerators: enumerators}
+}
+
+// Product yields every pairing of an item from first with an item from
+// second. The second enumerator is buffered in memory on first use so it can
+// be replayed for each item of first.
+func Product[A any, B any](first Enumerator[A], second Enumerator[B]) Enumerator[Pair[A, B]] {
+	var buffer []B
+	var err error
+	loaded := false
+	product := FlatMap(first, func(a A) Enumerator[Pair[A, B]] {
+		if !loaded {
+			loaded = true
+			buffer, err = ToSlice(second)
+		}
+		if err != nil {
+			return Error[Pair[A, B]](err)
+		}
+		return pairWith(a, Slice(buffer))
+	})
+
+	return Cleanup(product, func() {
+		if !loaded {
+			second.Dispose()
+		}
+	})
+}
+
+// ProductFunc yields every pairing of an item from first with an item from a
+// fresh enumerator created by factory for each item of first.
+func ProductFunc[A any, B any](first Enumerator[A], factory func() Enumerator[B]) Enumerator[Pair[A, B]] {
+	return FlatMap(first, func(a A) Enumerator[Pair[A, B]] {
+		return pairWith(a, factory())
+	})
+}
+
+func pairWith[A any, B any](a A, second Enumerator[B]) Enumerator[Pair[A, B]] {
+	return Map(second, func(b B) (Pair[A, B], error) {
+		return Pair[A, B]{First: a, Second: b}, nil
+	})
+}
@@ -0,0 +1,125 @@
+package enumerators_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/fgrzl/enumerators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZip_StopsAtShorterAndDisposes(t *testing.T) {
+	// Arrange
+	firstDisposed, secondDisposed := false, false
+	first := enumerators.Cleanup(enumerators.Slice([]int{1, 2, 3}), func() { firstDisposed = true })
+	second := enumerators.Cleanup(enumerators.Slice([]string{"a", "b"}), func() { secondDisposed = true })
+	zipped := enumerators.Zip(first, second, func(i int, s string) (string, error) {
+		return strconv.Itoa(i) + s, nil
+	})
+
+	// Act
+	var result []string
+	for zipped.MoveNext() {
+		item, _ := zipped.Current()
+		result = append(result, item)
+	}
+
+	// Assert
+	assert.NoError(t, zipped.Err())
+	assert.Equal(t, []string{"1a", "2b"}, result)
+	assert.True(t, firstDisposed)
+	assert.True(t, secondDisposed)
+}
+
+func TestZipLongest(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.ZipLongest(enumerators.Slice([]int{1}), enumerators.Slice([]string{"a", "b"})))
+
+	// Assert
+	assert.NoError(t, err)
+	expected := []enumerators.Joined[int, string]{
+		{Left: 1, Right: "a", HasLeft: true, HasRight: true},
+		{Right: "b", HasRight: true},
+	}
+	assert.Equal(t, expected, result)
+}
+
+func TestZipLongest_StopsPullingExhaustedSide(t *testing.T) {
+	// Arrange
+	calls := 0
+	short := enumerators.Generate(func() (int, bool, error) {
+		calls++
+		return calls, calls == 1, nil
+	})
+	long := enumerators.Slice([]string{"a", "b", "c"})
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.ZipLongest(short, long))
+
+	// Assert
+	assert.NoError(t, err)
+	expected := []enumerators.Joined[int, string]{
+		{Left: 1, Right: "a", HasLeft: true, HasRight: true},
+		{Right: "b", HasRight: true},
+		{Right: "c", HasRight: true},
+	}
+	assert.Equal(t, expected, result)
+	assert.Equal(t, 2, calls)
+}
+
+func TestZipN(t *testing.T) {
+	// Arrange
+	sources := []enumerators.Enumerator[int]{
+		enumerators.Slice([]int{1, 2, 3}),
+		enumerators.Slice([]int{4, 5}),
+		enumerators.Slice([]int{6, 7, 8}),
+	}
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.ZipN(sources))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, [][]int{{1, 4, 6}, {2, 5, 7}}, result)
+}
+
+func TestZip_BubbleError(t *testing.T) {
+	// Act
+	_, err := enumerators.ToSlice(enumerators.Zip(enumerators.Slice([]int{1}), enumerators.Error[int](errors.New("bubble error")), func(a, b int) (int, error) {
+		return a + b, nil
+	}))
+
+	// Assert
+	assert.EqualError(t, err, "bubble error")
+}
+
+func TestProduct(t *testing.T) {
+	// Act
+	result, err := enumerators.ToSlice(enumerators.Product(enumerators.Slice([]int{1, 2}), enumerators.Slice([]string{"a", "b"})))
+
+	// Assert
+	assert.NoError(t, err)
+	expected := []enumerators.Pair[int, string]{
+		{First: 1, Second: "a"}, {First: 1, Second: "b"},
+		{First: 2, Second: "a"}, {First: 2, Second: "b"},
+	}
+	assert.Equal(t, expected, result)
+}
+
+func TestProductFunc(t *testing.T) {
+	// Arrange
+	created := 0
+	factory := func() enumerators.Enumerator[string] {
+		created++
+		return enumerators.Slice([]string{"a", "b"})
+	}
+
+	// Act
+	result, err := enumerators.ToSlice(enumerators.ProductFunc(enumerators.Slice([]int{1, 2}), factory))
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, result, 4)
+	assert.Equal(t, 2, created)
+}